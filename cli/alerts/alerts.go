@@ -0,0 +1,58 @@
+package alerts
+
+import (
+	"log"
+
+	"github.com/MakeNowJust/heredoc"
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/auth"
+	"github.com/odpf/dex/cli/config"
+	"github.com/odpf/dex/generated/client"
+)
+
+func Commands() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "alert <command>",
+		Aliases: []string{"alerts"},
+		Short:   "Alert commands.",
+		Long:    "You can view the alerts triggered for firehoses, silence them and route them to receivers using this command.",
+		Example: heredoc.Doc(`
+			$ dex alerts list project-x
+			$ dex alerts list project-x --firehose orn:entropy:firehose:project-x:fh1 --severity CRITICAL
+			$ dex alerts silence create project-x orn:entropy:firehose:project-x:fh1 --duration 2h --reason "planned maintenance"
+			$ dex alerts subscription create project-x orn:entropy:firehose:project-x:fh1 --receiver 3 --config channel_name=pricing-alerts
+		`),
+		Annotations: map[string]string{
+			"group": "core",
+		},
+	}
+
+	cmd.AddCommand(
+		listCommand(),
+		silenceCommand(),
+		subscriptionCommand(),
+		receiversCommand(),
+	)
+
+	return cmd
+}
+
+func initClient(cmd *cobra.Command) *client.DexAPI {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("failed to load configs: %s", err)
+	}
+
+	accessToken, err := auth.Token(cmd.Context())
+	if err != nil {
+		log.Fatalf("failed to load configs: %s", err)
+	}
+
+	r := httptransport.New(cfg.Host, "/api", client.DefaultSchemes)
+	r.Context = cmd.Context()
+	r.DefaultAuthentication = httptransport.BearerToken(accessToken)
+	return client.New(r, strfmt.Default)
+}
@@ -0,0 +1,152 @@
+package alerts
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+)
+
+const listPageSize = 100
+
+func listCommand() *cobra.Command {
+	var firehoseURN, severity string
+	var since time.Duration
+	var from, to string
+
+	cmd := &cobra.Command{
+		Use:   "list <project>",
+		Short: "List alerts triggered for the firehoses of the project.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if since > 0 {
+				if from != "" {
+					return fmt.Errorf("only one of --since, --from can be specified")
+				}
+				from = time.Now().Add(-since).UTC().Format(time.RFC3339)
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			query := alertQuery{
+				from:     optionalString(from),
+				to:       optionalString(to),
+				severity: optionalString(strings.ToUpper(severity)),
+			}
+
+			var alerts []*models.Alert
+			var err error
+			if firehoseURN != "" {
+				alerts, err = listFirehoseAlerts(client, args[0], firehoseURN, query)
+			} else {
+				alerts, err = listProjectAlerts(client, args[0], query)
+			}
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return cdk.Display(cmd, alerts, func(w io.Writer, v interface{}) error {
+				report := [][]string{
+					{term.Bold("TRIGGERED AT"), term.Bold("FIREHOSE"), term.Bold("SEVERITY"), term.Bold("METRIC"), term.Bold("VALUE")},
+				}
+				for _, a := range alerts {
+					report = append(report, []string{a.TriggeredAt.String(), a.Urn, a.Severity, a.Metric, a.Value})
+				}
+
+				fmt.Printf("Showing %d alerts\n", len(alerts))
+				printer.Table(w, report)
+				return nil
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&firehoseURN, "firehose", "", "Only list alerts of this firehose")
+	flags.StringVarP(&severity, "severity", "s", "", "Only list alerts with these comma separated severities")
+	flags.DurationVar(&since, "since", 0, "Only list alerts triggered within this duration (e.g. 24h)")
+	flags.StringVar(&from, "from", "", "Only list alerts triggered at or after this RFC3339 timestamp")
+	flags.StringVar(&to, "to", "", "Only list alerts triggered at or before this RFC3339 timestamp")
+	return cmd
+}
+
+type alertQuery struct {
+	from, to, severity *string
+}
+
+// listProjectAlerts follows the pages until server indicates there are
+// no more.
+func listProjectAlerts(client *client.DexAPI, project string, query alertQuery) ([]*models.Alert, error) {
+	pageSize := int64(listPageSize)
+	params := operations.ListProjectAlertsParams{
+		ProjectSlug: project,
+		From:        query.from,
+		To:          query.to,
+		Severity:    query.severity,
+		PageSize:    &pageSize,
+	}
+
+	var alerts []*models.Alert
+	for {
+		params.SetTimeout(10 * time.Second)
+		res, err := client.Operations.ListProjectAlerts(&params)
+		if err != nil {
+			return nil, err
+		}
+
+		payload := res.GetPayload()
+		alerts = append(alerts, payload.Items...)
+		if payload.NextPageToken == "" {
+			return alerts, nil
+		}
+		params.PageToken = &payload.NextPageToken
+	}
+}
+
+func listFirehoseAlerts(client *client.DexAPI, project, urn string, query alertQuery) ([]*models.Alert, error) {
+	pageSize := int64(listPageSize)
+	params := operations.GetFirehoseAlertsParams{
+		ProjectSlug: project,
+		FirehoseUrn: urn,
+		From:        query.from,
+		To:          query.to,
+		Severity:    query.severity,
+		PageSize:    &pageSize,
+	}
+
+	var alerts []*models.Alert
+	for {
+		params.SetTimeout(10 * time.Second)
+		res, err := client.Operations.GetFirehoseAlerts(&params)
+		if err != nil {
+			return nil, err
+		}
+
+		payload := res.GetPayload()
+		alerts = append(alerts, payload.Items...)
+		if payload.NextPageToken == "" {
+			return alerts, nil
+		}
+		params.PageToken = &payload.NextPageToken
+	}
+}
+
+func optionalString(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
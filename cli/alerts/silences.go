@@ -0,0 +1,162 @@
+package alerts
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+)
+
+func silenceCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "silence <command>",
+		Aliases: []string{"silences"},
+		Short:   "Silence alerts of a firehose for a while.",
+		Example: heredoc.Doc(`
+			$ dex alerts silence create project-x orn:entropy:firehose:project-x:fh1 --duration 2h --reason "planned maintenance"
+			$ dex alerts silence list project-x orn:entropy:firehose:project-x:fh1
+			$ dex alerts silence delete project-x orn:entropy:firehose:project-x:fh1 <silence-id>
+		`),
+	}
+
+	cmd.AddCommand(
+		createSilenceCommand(),
+		listSilencesCommand(),
+		deleteSilenceCommand(),
+	)
+	return cmd
+}
+
+func createSilenceCommand() *cobra.Command {
+	var duration time.Duration
+	var reason string
+	var templates []string
+
+	cmd := &cobra.Command{
+		Use:   "create <project> <firehoseURN>",
+		Short: "Silence alert rules of a firehose for the given duration.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if duration <= 0 {
+				return fmt.Errorf("--duration must be specified")
+			} else if strings.TrimSpace(reason) == "" {
+				return fmt.Errorf("--reason must be specified")
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.CreateAlertSilenceParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+				Body: &models.CreateAlertSilenceRequest{
+					Duration:  duration.String(),
+					Reason:    reason,
+					Templates: templates,
+				},
+			}
+			params.WithTimeout(30 * time.Second)
+
+			res, err := client.Operations.CreateAlertSilence(params)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			silence := res.GetPayload()
+			return cdk.Display(cmd, silence, func(w io.Writer, v interface{}) error {
+				fmt.Fprintf(w, "Silenced %s until %s (id: %s)\n",
+					strings.Join(silence.Templates, ", "), silence.ExpiresAt, silence.ID)
+				return nil
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.DurationVarP(&duration, "duration", "d", 0, "Duration of the silence (e.g. 2h), at most 168h")
+	flags.StringVarP(&reason, "reason", "r", "", "Reason for silencing the alerts")
+	flags.StringSliceVarP(&templates, "template", "t", nil, "Templates of the alert rules to silence. All enabled rules if not specified")
+	return cmd
+}
+
+func listSilencesCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <project> <firehoseURN>",
+		Short: "List active alert silences of a firehose.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.ListAlertSilencesParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+			}
+			params.WithTimeout(10 * time.Second)
+
+			res, err := client.Operations.ListAlertSilences(params)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			silences := res.GetPayload().Items
+			return cdk.Display(cmd, silences, func(w io.Writer, v interface{}) error {
+				report := [][]string{
+					{term.Bold("ID"), term.Bold("TEMPLATES"), term.Bold("EXPIRES AT"), term.Bold("CREATED BY"), term.Bold("REASON")},
+				}
+				for _, s := range silences {
+					report = append(report, []string{
+						s.ID, strings.Join(s.Templates, ", "), s.ExpiresAt.String(), s.CreatedByEmail, s.Reason,
+					})
+				}
+
+				fmt.Printf("Showing %d active silences\n", len(silences))
+				printer.Table(w, report)
+				return nil
+			})
+		},
+	}
+}
+
+func deleteSilenceCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <project> <firehoseURN> <silenceID>",
+		Short: "End an alert silence and enable the silenced rules.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.DeleteAlertSilenceParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+				SilenceID:   args[2],
+			}
+			params.WithTimeout(30 * time.Second)
+
+			if _, err := client.Operations.DeleteAlertSilence(params); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("Ended alert silence %s\n", args[2])
+			return nil
+		},
+	}
+}
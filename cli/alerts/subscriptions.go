@@ -0,0 +1,224 @@
+package alerts
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+)
+
+func subscriptionCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "subscription <command>",
+		Aliases: []string{"subscriptions"},
+		Short:   "Route alerts of a firehose to Siren receivers.",
+		Example: heredoc.Doc(`
+			$ dex alerts subscription list project-x orn:entropy:firehose:project-x:fh1
+			$ dex alerts subscription create project-x orn:entropy:firehose:project-x:fh1 --receiver 3 --config channel_name=pricing-alerts
+			$ dex alerts subscription create project-x orn:entropy:firehose:project-x:fh1 --receiver 5 --match severity=CRITICAL
+			$ dex alerts subscription delete project-x orn:entropy:firehose:project-x:fh1 12
+		`),
+	}
+
+	cmd.AddCommand(
+		listSubscriptionsCommand(),
+		createSubscriptionCommand(),
+		deleteSubscriptionCommand(),
+	)
+	return cmd
+}
+
+func listSubscriptionsCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list <project> <firehoseURN>",
+		Short: "List alert subscriptions of a firehose.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.ListFirehoseSubscriptionsParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+			}
+			params.WithTimeout(10 * time.Second)
+
+			res, err := client.Operations.ListFirehoseSubscriptions(params)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			subscriptions := res.GetPayload().Items
+			return cdk.Display(cmd, subscriptions, func(w io.Writer, v interface{}) error {
+				report := [][]string{
+					{term.Bold("ID"), term.Bold("URN"), term.Bold("RECEIVERS"), term.Bold("MATCH")},
+				}
+				for _, s := range subscriptions {
+					var receivers []string
+					for _, r := range s.Receivers {
+						receivers = append(receivers, r.ID)
+					}
+					report = append(report, []string{s.ID, s.Urn, strings.Join(receivers, ", "), formatLabels(s.Match)})
+				}
+
+				fmt.Printf("Showing %d subscriptions\n", len(subscriptions))
+				printer.Table(w, report)
+				return nil
+			})
+		},
+	}
+}
+
+func createSubscriptionCommand() *cobra.Command {
+	var urn string
+	var receivers []string
+	var configs, match map[string]string
+
+	cmd := &cobra.Command{
+		Use:   "create <project> <firehoseURN>",
+		Short: "Route alerts of a firehose to receivers.",
+		Long: heredoc.Doc(`
+			Route alerts of a firehose to receivers.
+
+			Alerts are matched by the release name of the firehose, along with the
+			labels given by --match. Receivers can be listed using 'dex alerts receivers <project>'.
+		`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(receivers) == 0 {
+				return fmt.Errorf("at least one --receiver must be specified")
+			}
+
+			body := &models.CreateSubscriptionRequest{
+				Urn:   urn,
+				Match: match,
+			}
+			for _, id := range receivers {
+				body.Receivers = append(body.Receivers, &models.SubscriptionReceiver{
+					ID:            id,
+					Configuration: configs,
+				})
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.CreateFirehoseSubscriptionParams{
+				ProjectSlug: args[0],
+				FirehoseUrn: args[1],
+				Body:        body,
+			}
+			params.WithTimeout(30 * time.Second)
+
+			res, err := client.Operations.CreateFirehoseSubscription(params)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			sub := res.GetPayload()
+			return cdk.Display(cmd, sub, func(w io.Writer, v interface{}) error {
+				fmt.Fprintf(w, "Created subscription %s (id: %s) matching %s\n", sub.Urn, sub.ID, formatLabels(sub.Match))
+				return nil
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&urn, "urn", "", "URN of the subscription. Generated from the release name if not specified")
+	flags.StringSliceVarP(&receivers, "receiver", "r", nil, "IDs of the receivers to route the alerts to")
+	flags.StringToStringVarP(&configs, "config", "c", nil, "Receiver configuration (e.g. channel_name=pricing-alerts)")
+	flags.StringToStringVarP(&match, "match", "m", nil, "Additional labels the alerts must have (e.g. severity=CRITICAL)")
+	return cmd
+}
+
+func deleteSubscriptionCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete <project> <firehoseURN> <subscriptionID>",
+		Short: "Delete an alert subscription of a firehose.",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.DeleteFirehoseSubscriptionParams{
+				ProjectSlug:    args[0],
+				FirehoseUrn:    args[1],
+				SubscriptionID: args[2],
+			}
+			params.WithTimeout(30 * time.Second)
+
+			if _, err := client.Operations.DeleteFirehoseSubscription(params); err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			fmt.Printf("Deleted subscription %s\n", args[2])
+			return nil
+		},
+	}
+}
+
+func receiversCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "receivers <project>",
+		Short: "List receivers the alerts of a project can be routed to.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.ListAlertReceiversParams{
+				ProjectSlug: args[0],
+			}
+			params.WithTimeout(10 * time.Second)
+
+			res, err := client.Operations.ListAlertReceivers(params)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			receivers := res.GetPayload().Items
+			return cdk.Display(cmd, receivers, func(w io.Writer, v interface{}) error {
+				report := [][]string{
+					{term.Bold("ID"), term.Bold("NAME"), term.Bold("TYPE")},
+				}
+				for _, r := range receivers {
+					report = append(report, []string{r.ID, r.Name, r.Type})
+				}
+
+				printer.Table(w, report)
+				return nil
+			})
+		},
+	}
+}
+
+func formatLabels(labels map[string]string) string {
+	var pairs []string
+	for k, v := range labels {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
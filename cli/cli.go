@@ -5,6 +5,7 @@ import (
 	"github.com/odpf/salt/cmdx"
 	"github.com/spf13/cobra"
 
+	"github.com/odpf/dex/cli/alerts"
 	"github.com/odpf/dex/cli/auth"
 	"github.com/odpf/dex/cli/config"
 	"github.com/odpf/dex/cli/firehoses"
@@ -53,6 +54,7 @@ func New() *cobra.Command {
 		server.Commands(),
 		projects.Commands(),
 		firehoses.Commands(),
+		alerts.Commands(),
 	)
 
 	// Help topics.
@@ -0,0 +1,115 @@
+package firehoses
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
+)
+
+func alertPolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "alert-policy <command>",
+		Short: "Firehose alert policy management commands.",
+	}
+
+	cmd.AddCommand(
+		copyAlertPolicyCommand(),
+	)
+	return cmd
+}
+
+func copyAlertPolicyCommand() *cobra.Command {
+	var from, file, group, sinkType string
+	var to []string
+
+	cmd := &cobra.Command{
+		Use:   "copy <project>",
+		Short: "Apply the alert policy of a firehose or a file to other firehoses",
+		Example: heredoc.Doc(`
+			$ dex firehose alert-policy copy project-x --from orn:entropy:firehose:project-x:fh1 --to orn:entropy:firehose:project-x:fh2
+			$ dex firehose alert-policy copy project-x --file ./policy.yaml --group pricing --sink-type bigquery
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			body := &models.CopyAlertPolicyRequest{
+				SourceUrn:  from,
+				TargetUrns: to,
+			}
+
+			if (from == "") == (file == "") {
+				return errors.New("exactly one of --from, --file must be specified")
+			} else if file != "" {
+				var policy models.AlertPolicy
+				if err := readYAMLFile(file, &policy); err != nil {
+					return err
+				}
+				body.Policy = &policy
+			}
+
+			if group != "" || sinkType != "" {
+				body.Filter = &models.CopyAlertPolicyFilter{
+					Group:    group,
+					SinkType: strings.ToUpper(sinkType),
+				}
+			}
+
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.CopyAlertPolicyParams{
+				ProjectSlug: args[0],
+				Body:        body,
+			}
+			params.WithTimeout(60 * time.Second)
+
+			res, err := client.Operations.CopyAlertPolicy(params)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			results := res.GetPayload().Items
+			return cdk.Display(cmd, results, func(w io.Writer, v interface{}) error {
+				report := [][]string{
+					{term.Bold("URN"), term.Bold("RESULT")},
+				}
+				applied := 0
+				for _, r := range results {
+					result := "applied"
+					if r.Error != "" {
+						result = term.Red(r.Error)
+					} else {
+						applied++
+					}
+					report = append(report, []string{r.Urn, result})
+				}
+
+				fmt.Printf("Applied alert policy to %d of %d firehoses\n", applied, len(results))
+				printer.Table(os.Stdout, report)
+				return nil
+			})
+		},
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&from, "from", "", "URN of the firehose to copy the alert policy from")
+	flags.StringVarP(&file, "file", "f", "", "Path of the alert policy file to apply")
+	flags.StringSliceVar(&to, "to", nil, "URNs of the firehoses to apply the alert policy to")
+	flags.StringVarP(&group, "group", "g", "", "Apply to firehoses belonging to this group")
+	flags.StringVar(&sinkType, "sink-type", "", "Apply to firehoses with this sink type")
+	return cmd
+}
@@ -17,6 +17,7 @@ import (
 
 func applyCommand() *cobra.Command {
 	var configFile string
+	var dryRun, skipDefaultAlerts bool
 
 	cmd := &cobra.Command{
 		Use:   "apply <project> <filepath>",
@@ -49,11 +50,17 @@ func applyCommand() *cobra.Command {
 				params := &operations.UpdateFirehoseParams{
 					ProjectSlug: args[0],
 					FirehoseUrn: existing.Urn,
+					DryRun:      &dryRun,
 					Body: operations.UpdateFirehoseBody{
-						Config: firehoseDef.Configs,
+						Configs:     firehoseDef.Configs,
+						Description: firehoseDef.Description,
 					},
 				}
 				params.WithTimeout(10 * time.Second)
+				if resp.ETag != "" {
+					// Guard against overwriting changes made since we read it.
+					params.WithIfMatch(&resp.ETag)
+				}
 
 				updated, updateErr := client.Operations.UpdateFirehose(params)
 				if updateErr != nil {
@@ -63,23 +70,34 @@ func applyCommand() *cobra.Command {
 			} else {
 				// Firehose does not already exist. Treat this as create.
 				params := &operations.CreateFirehoseParams{
-					Body:        &firehoseDef,
-					ProjectSlug: args[0],
+					Body:              &firehoseDef,
+					DryRun:            &dryRun,
+					ProjectSlug:       args[0],
+					SkipDefaultAlerts: &skipDefaultAlerts,
 				}
 				params.WithTimeout(10 * time.Second)
 
-				created, createErr := client.Operations.CreateFirehose(params)
+				dryRunResult, created, createErr := client.Operations.CreateFirehose(params)
 				if createErr != nil {
 					return createErr
+				} else if dryRunResult != nil {
+					finalVersion = dryRunResult.GetPayload()
+				} else {
+					finalVersion = created.GetPayload()
 				}
-				finalVersion = created.GetPayload()
 			}
+			spinner.Stop()
 
+			if dryRun {
+				return displayDryRun(cmd, finalVersion)
+			}
 			return cdk.Display(cmd, finalVersion, cdk.YAMLFormat)
 		},
 	}
 
 	cmd.Flags().StringVarP(&configFile, "config", "c", "./config.yaml", "Config file path")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes that would be applied")
+	cmd.Flags().BoolVar(&skipDefaultAlerts, "skip-default-alerts", false, "Do not apply the default alert policy of the project on create")
 	return cmd
 }
 
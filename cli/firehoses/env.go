@@ -0,0 +1,94 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/odpf/salt/printer"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+)
+
+func setEnvCommand() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "set-env <project> <firehoseURN> <KEY=VALUE>...",
+		Short: "Set environment variables of the firehose",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			envVars := map[string]interface{}{}
+			for _, kv := range args[2:] {
+				key, value, found := strings.Cut(kv, "=")
+				if !found || strings.TrimSpace(key) == "" {
+					return fmt.Errorf("invalid env var '%s', must be of the form KEY=VALUE", kv)
+				}
+				envVars[strings.TrimSpace(key)] = value
+			}
+
+			return patchEnvVars(cmd, args[0], args[1], envVars, dryRun)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes that would be applied")
+	return cmd
+}
+
+func unsetEnvCommand() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "unset-env <project> <firehoseURN> <KEY>...",
+		Short: "Remove environment variables of the firehose",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			envVars := map[string]interface{}{}
+			for _, key := range args[2:] {
+				envVars[strings.TrimSpace(key)] = nil
+			}
+
+			return patchEnvVars(cmd, args[0], args[1], envVars, dryRun)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes that would be applied")
+	return cmd
+}
+
+// patchEnvVars sends a merge-patch for the env vars of the firehose. Nil
+// values remove the env var.
+func patchEnvVars(cmd *cobra.Command, project, urn string, envVars map[string]interface{}, dryRun bool) error {
+	spinner := printer.Spin("")
+	defer spinner.Stop()
+
+	client := initClient(cmd)
+
+	params := &operations.PatchFirehoseParams{
+		FirehoseUrn: urn,
+		ProjectSlug: project,
+		DryRun:      &dryRun,
+		Body: map[string]interface{}{
+			"configs": map[string]interface{}{
+				"env_vars": envVars,
+			},
+		},
+	}
+
+	modifiedFirehose, err := client.Operations.PatchFirehose(params)
+	if err != nil {
+		return err
+	}
+	spinner.Stop()
+
+	if dryRun {
+		return displayDryRun(cmd, modifiedFirehose.GetPayload())
+	}
+
+	return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
+		_, err := fmt.Fprintln(w, "Update request accepted. Use view command to check status.")
+		return err
+	})
+}
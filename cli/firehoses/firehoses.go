@@ -1,16 +1,21 @@
 package firehoses
 
 import (
+	"fmt"
+	"io"
 	"log"
 
 	"github.com/MakeNowJust/heredoc"
+	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 	"github.com/spf13/cobra"
 
 	"github.com/odpf/dex/cli/auth"
+	"github.com/odpf/dex/cli/cdk"
 	"github.com/odpf/dex/cli/config"
 	"github.com/odpf/dex/generated/client"
+	"github.com/odpf/dex/generated/models"
 )
 
 func Commands() *cobra.Command {
@@ -38,6 +43,11 @@ func Commands() *cobra.Command {
 		logsCommand(),
 		upgradeCommand(),
 		resetOffsetCommand(),
+		schemaCommand(),
+		rollbackCommand(),
+		setEnvCommand(),
+		unsetEnvCommand(),
+		alertPolicyCommand(),
 	)
 	return cmd
 }
@@ -56,5 +66,22 @@ func initClient(cmd *cobra.Command) *client.DexAPI {
 	r := httptransport.New(cfg.Host, "/api", client.DefaultSchemes)
 	r.Context = cmd.Context()
 	r.DefaultAuthentication = httptransport.BearerToken(accessToken)
+	r.Producers["application/merge-patch+json"] = runtime.JSONProducer()
 	return client.New(r, strfmt.Default)
 }
+
+// displayDryRun displays the would-be firehose returned by the server for
+// a dry-run request. The pretty format shows only the diff.
+func displayDryRun(cmd *cobra.Command, firehose *models.Firehose) error {
+	return cdk.Display(cmd, firehose, func(w io.Writer, v interface{}) error {
+		var diff interface{}
+		if firehose.DryRun != nil {
+			diff = firehose.DryRun.Diff
+		}
+
+		if _, err := fmt.Fprintln(w, "Dry-run: no changes were applied. Diff against the current spec:"); err != nil {
+			return err
+		}
+		return cdk.JSONFormat(w, diff)
+	})
+}
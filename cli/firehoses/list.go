@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/odpf/salt/printer"
@@ -12,9 +13,14 @@ import (
 
 	"github.com/odpf/dex/cli/cdk"
 	"github.com/odpf/dex/generated/client/operations"
+	"github.com/odpf/dex/generated/models"
 )
 
+const listPageSize = 100
+
 func listCommand() *cobra.Command {
+	var group, kubeCluster, status, topicName, streamName, sinkType, sortBy string
+
 	cmd := &cobra.Command{
 		Use:   "list <project>",
 		Short: "List firehoses in the given project.",
@@ -25,15 +31,37 @@ func listCommand() *cobra.Command {
 
 			client := initClient(cmd)
 
+			pageSize := int64(listPageSize)
+			view := "full"
 			params := operations.ListFirehosesParams{
 				ProjectSlug: args[0],
+				Group:       optionalString(group),
+				KubeCluster: optionalString(kubeCluster),
+				Status:      optionalString(strings.ToUpper(status)),
+				TopicName:   optionalString(topicName),
+				StreamName:  optionalString(streamName),
+				SinkType:    optionalString(strings.ToUpper(sinkType)),
+				SortBy:      optionalString(sortBy),
+				PageSize:    &pageSize,
+				View:        &view,
 			}
-			params.SetTimeout(10 * time.Second)
-			res, err := client.Operations.ListFirehoses(&params)
-			if err != nil {
-				return err
+
+			// follow the pages until server indicates there are no more.
+			var firehoses []*models.Firehose
+			for {
+				params.SetTimeout(10 * time.Second)
+				res, err := client.Operations.ListFirehoses(&params)
+				if err != nil {
+					return err
+				}
+
+				payload := res.GetPayload()
+				firehoses = append(firehoses, payload.Items...)
+				if payload.NextPageToken == "" {
+					break
+				}
+				params.PageToken = &payload.NextPageToken
 			}
-			firehoses := res.GetPayload().Items
 			spinner.Stop()
 
 			return cdk.Display(cmd, firehoses, func(w io.Writer, v interface{}) error {
@@ -41,7 +69,11 @@ func listCommand() *cobra.Command {
 					{term.Bold("URN"), term.Bold("NAME"), term.Bold("VERSION")},
 				}
 				for _, f := range firehoses {
-					report = append(report, []string{f.Urn, f.Name, f.Configs.Version})
+					var version string
+					if f.Configs != nil {
+						version = f.Configs.Version
+					}
+					report = append(report, []string{f.Urn, f.Name, version})
 				}
 
 				fmt.Printf("Showing %d firehoses\n", len(firehoses))
@@ -50,5 +82,22 @@ func listCommand() *cobra.Command {
 			})
 		},
 	}
+
+	flags := cmd.Flags()
+	flags.StringVarP(&group, "group", "g", "", "Only list firehoses belonging to this group")
+	flags.StringVar(&kubeCluster, "kube-cluster", "", "Only list firehoses deployed to this kubernetes cluster")
+	flags.StringVarP(&status, "status", "s", "", "Only list firehoses with this status (running, stopped)")
+	flags.StringVar(&topicName, "topic", "", "Only list firehoses consuming from this topic")
+	flags.StringVar(&streamName, "stream", "", "Only list firehoses consuming from this stream")
+	flags.StringVar(&sinkType, "sink-type", "", "Only list firehoses with this sink type")
+	flags.StringVar(&sortBy, "sort-by", "", "Sort by name, created_at or updated_at (prefix with '-' for descending)")
 	return cmd
 }
+
+func optionalString(s string) *string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,56 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/odpf/salt/printer"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+)
+
+func rollbackCommand() *cobra.Command {
+	var revision string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "rollback <project> <firehoseURN>",
+		Short: "Roll back the firehose to a previous revision",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			params := &operations.RollbackFirehoseParams{
+				FirehoseUrn: args[1],
+				ProjectSlug: args[0],
+				Revision:    revision,
+				DryRun:      &dryRun,
+			}
+
+			modifiedFirehose, err := client.Operations.RollbackFirehose(params)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			if dryRun {
+				return displayDryRun(cmd, modifiedFirehose.GetPayload())
+			}
+
+			return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
+				_, err := fmt.Fprintf(w, "Rolled back to revision %s. Use view command to check status.\n", revision)
+				return err
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&revision, "to", "", "ID of the revision to roll back to")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes that would be applied")
+	_ = cmd.MarkFlagRequired("to")
+	return cmd
+}
@@ -13,6 +13,7 @@ import (
 
 func scaleCommand() *cobra.Command {
 	var replicas int
+	var dryRun bool
 
 	cmd := &cobra.Command{
 		Use:   "scale <project> <firehoseURN>",
@@ -28,6 +29,7 @@ func scaleCommand() *cobra.Command {
 			params := &operations.ScaleFirehoseParams{
 				FirehoseUrn: args[1],
 				ProjectSlug: args[0],
+				DryRun:      &dryRun,
 				Body: operations.ScaleFirehoseBody{
 					Replicas: &replicasNum,
 				},
@@ -39,6 +41,10 @@ func scaleCommand() *cobra.Command {
 			}
 			spinner.Stop()
 
+			if dryRun {
+				return displayDryRun(cmd, modifiedFirehose.GetPayload())
+			}
+
 			return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
 				_, err := fmt.Fprintln(w, "Scale request accepted. Use view command to check status.")
 				return err
@@ -47,5 +53,6 @@ func scaleCommand() *cobra.Command {
 	}
 
 	cmd.Flags().IntVarP(&replicas, "replicas", "r", 1, "Number of replicas to run")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes that would be applied")
 	return cmd
 }
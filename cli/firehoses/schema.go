@@ -0,0 +1,68 @@
+package firehoses
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/odpf/salt/printer"
+	"github.com/odpf/salt/term"
+	"github.com/spf13/cobra"
+
+	"github.com/odpf/dex/cli/cdk"
+	"github.com/odpf/dex/generated/client/operations"
+)
+
+func schemaCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema [sink-type]",
+		Short: "View JSON Schema of firehose configs",
+		Long:  "List the supported sink types, or display the JSON Schema of the firehose configs for the given sink type",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			spinner := printer.Spin("")
+			defer spinner.Stop()
+
+			client := initClient(cmd)
+
+			if len(args) == 0 {
+				params := operations.ListSinkTypesParams{}
+
+				res, err := client.Operations.ListSinkTypes(&params)
+				if err != nil {
+					return err
+				}
+				sinkTypes := res.GetPayload().Items
+				spinner.Stop()
+
+				return cdk.Display(cmd, sinkTypes, func(w io.Writer, v interface{}) error {
+					report := [][]string{
+						{term.Bold("TYPE"), term.Bold("VERSION"), term.Bold("ENV PREFIX")},
+					}
+					for _, st := range sinkTypes {
+						report = append(report, []string{string(st.Type), st.Version, st.EnvVarPrefix})
+					}
+
+					fmt.Printf("Showing %d sink types\n", len(sinkTypes))
+					printer.Table(os.Stdout, report)
+					return nil
+				})
+			}
+
+			params := operations.GetSinkTypeSchemaParams{
+				SinkType: strings.ToUpper(args[0]),
+			}
+
+			res, err := client.Operations.GetSinkTypeSchema(&params)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return cdk.Display(cmd, res.GetPayload(), cdk.JSONFormat)
+		},
+	}
+
+	return cmd
+}
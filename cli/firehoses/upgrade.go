@@ -13,6 +13,8 @@ import (
 )
 
 func upgradeCommand() *cobra.Command {
+	var dryRun bool
+
 	cmd := &cobra.Command{
 		Use:   "upgrade <project> <firehoseURN>",
 		Short: "Upgrade the firehose to the latest version supported",
@@ -24,6 +26,7 @@ func upgradeCommand() *cobra.Command {
 			params := &operations.UpgradeFirehoseParams{
 				FirehoseUrn: args[1],
 				ProjectSlug: args[0],
+				DryRun:      &dryRun,
 				Body:        struct{}{},
 			}
 
@@ -32,6 +35,11 @@ func upgradeCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			spinner.Stop()
+
+			if dryRun {
+				return displayDryRun(cmd, modifiedFirehose.GetPayload())
+			}
 
 			return cdk.Display(cmd, modifiedFirehose, func(w io.Writer, v interface{}) error {
 				_, err := fmt.Fprintln(w, "Upgrade request accepted. Use view command to check status.")
@@ -39,5 +47,7 @@ func upgradeCommand() *cobra.Command {
 			})
 		},
 	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes that would be applied")
 	return cmd
 }
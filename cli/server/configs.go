@@ -6,6 +6,10 @@ import (
 	"github.com/odpf/salt/config"
 	"github.com/spf13/cobra"
 
+	"github.com/odpf/dex/internal/server/authn"
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	firehosesv1 "github.com/odpf/dex/internal/server/v1/firehose"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
 	"github.com/odpf/dex/pkg/errors"
 	"github.com/odpf/dex/pkg/logger"
 	"github.com/odpf/dex/pkg/telemetry"
@@ -15,6 +19,7 @@ import (
 type serverConfig struct {
 	Log       logger.LogConfig `mapstructure:"log"`
 	Service   serveConfig      `mapstructure:"service"`
+	Auth      authn.Config     `mapstructure:"auth"`
 	Shield    shieldConfig     `mapstructure:"shield"`
 	Entropy   entropyConfig    `mapstructure:"entropy"`
 	Siren     sirenConfig      `mapstructure:"siren"`
@@ -22,7 +27,9 @@ type serverConfig struct {
 }
 
 type shieldConfig struct {
-	Addr string `mapstructure:"addr"`
+	Addr         string                  `mapstructure:"addr"`
+	Authz        firehosesv1.AuthzConfig `mapstructure:"authz"`
+	ProjectCache projectsv1.CacheConfig  `mapstructure:"project_cache"`
 }
 
 type entropyConfig struct {
@@ -31,7 +38,8 @@ type entropyConfig struct {
 }
 
 type sirenConfig struct {
-	Addr string `mapstructure:"addr"`
+	Addr           string                        `mapstructure:"addr"`
+	NamespaceCache alertsv1.NamespaceCacheConfig `mapstructure:"namespace_cache"`
 }
 
 type serveConfig struct {
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/odpf/dex/generated/models"
 )
@@ -66,12 +67,24 @@ type CreateFirehoseParams struct {
 	// Body.
 	Body *models.Firehose
 
+	/* DryRun.
+
+	   Validate and return the would-be firehose along with the diff, without applying the changes.
+	*/
+	DryRun *bool
+
 	/* ProjectSlug.
 
 	   Unique identifier of the project.
 	*/
 	ProjectSlug string
 
+	/* SkipDefaultAlerts.
+
+	   Do not apply the default alert policy of the project to the created firehose.
+	*/
+	SkipDefaultAlerts *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -136,6 +149,17 @@ func (o *CreateFirehoseParams) SetBody(body *models.Firehose) {
 	o.Body = body
 }
 
+// WithDryRun adds the dryRun to the create firehose params
+func (o *CreateFirehoseParams) WithDryRun(dryRun *bool) *CreateFirehoseParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the create firehose params
+func (o *CreateFirehoseParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
 // WithProjectSlug adds the projectSlug to the create firehose params
 func (o *CreateFirehoseParams) WithProjectSlug(projectSlug string) *CreateFirehoseParams {
 	o.SetProjectSlug(projectSlug)
@@ -147,6 +171,17 @@ func (o *CreateFirehoseParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithSkipDefaultAlerts adds the skipDefaultAlerts to the create firehose params
+func (o *CreateFirehoseParams) WithSkipDefaultAlerts(skipDefaultAlerts *bool) *CreateFirehoseParams {
+	o.SetSkipDefaultAlerts(skipDefaultAlerts)
+	return o
+}
+
+// SetSkipDefaultAlerts adds the skipDefaultAlerts to the create firehose params
+func (o *CreateFirehoseParams) SetSkipDefaultAlerts(skipDefaultAlerts *bool) {
+	o.SkipDefaultAlerts = skipDefaultAlerts
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *CreateFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -160,11 +195,45 @@ func (o *CreateFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		}
 	}
 
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
 	}
 
+	if o.SkipDefaultAlerts != nil {
+
+		// query param skip_default_alerts
+		var qrSkipDefaultAlerts bool
+
+		if o.SkipDefaultAlerts != nil {
+			qrSkipDefaultAlerts = *o.SkipDefaultAlerts
+		}
+		qSkipDefaultAlerts := swag.FormatBool(qrSkipDefaultAlerts)
+		if qSkipDefaultAlerts != "" {
+
+			if err := r.SetQueryParam("skip_default_alerts", qSkipDefaultAlerts); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
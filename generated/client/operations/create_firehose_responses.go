@@ -23,12 +23,24 @@ type CreateFirehoseReader struct {
 // ReadResponse reads a server response into the received o.
 func (o *CreateFirehoseReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
 	switch response.Code() {
+	case 200:
+		result := NewCreateFirehoseOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
 	case 201:
 		result := NewCreateFirehoseCreated()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewCreateFirehoseBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 409:
 		result := NewCreateFirehoseConflict()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -46,6 +58,69 @@ func (o *CreateFirehoseReader) ReadResponse(response runtime.ClientResponse, con
 	}
 }
 
+// NewCreateFirehoseOK creates a CreateFirehoseOK with default headers values
+func NewCreateFirehoseOK() *CreateFirehoseOK {
+	return &CreateFirehoseOK{}
+}
+
+/*
+CreateFirehoseOK describes a response with status code 200, with default header values.
+
+Dry-run result. Firehose is not created.
+*/
+type CreateFirehoseOK struct {
+	Payload *models.Firehose
+}
+
+// IsSuccess returns true when this create firehose o k response has a 2xx status code
+func (o *CreateFirehoseOK) IsSuccess() bool {
+	return true
+}
+
+// IsRedirect returns true when this create firehose o k response has a 3xx status code
+func (o *CreateFirehoseOK) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose o k response has a 4xx status code
+func (o *CreateFirehoseOK) IsClientError() bool {
+	return false
+}
+
+// IsServerError returns true when this create firehose o k response has a 5xx status code
+func (o *CreateFirehoseOK) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose o k response a status code equal to that given
+func (o *CreateFirehoseOK) IsCode(code int) bool {
+	return code == 200
+}
+
+func (o *CreateFirehoseOK) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses][%d] createFirehoseOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateFirehoseOK) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses][%d] createFirehoseOK  %+v", 200, o.Payload)
+}
+
+func (o *CreateFirehoseOK) GetPayload() *models.Firehose {
+	return o.Payload
+}
+
+func (o *CreateFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Firehose)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreateFirehoseCreated creates a CreateFirehoseCreated with default headers values
 func NewCreateFirehoseCreated() *CreateFirehoseCreated {
 	return &CreateFirehoseCreated{}
@@ -54,7 +129,7 @@ func NewCreateFirehoseCreated() *CreateFirehoseCreated {
 /*
 CreateFirehoseCreated describes a response with status code 201, with default header values.
 
-Successfully created
+Successfully created. Includes the report of the default alert policy of the project applied to the firehose.
 */
 type CreateFirehoseCreated struct {
 	Payload *models.Firehose
@@ -109,6 +184,69 @@ func (o *CreateFirehoseCreated) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewCreateFirehoseBadRequest creates a CreateFirehoseBadRequest with default headers values
+func NewCreateFirehoseBadRequest() *CreateFirehoseBadRequest {
+	return &CreateFirehoseBadRequest{}
+}
+
+/*
+CreateFirehoseBadRequest describes a response with status code 400, with default header values.
+
+Firehose configs are not valid.
+*/
+type CreateFirehoseBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this create firehose bad request response has a 2xx status code
+func (o *CreateFirehoseBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this create firehose bad request response has a 3xx status code
+func (o *CreateFirehoseBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this create firehose bad request response has a 4xx status code
+func (o *CreateFirehoseBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this create firehose bad request response has a 5xx status code
+func (o *CreateFirehoseBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this create firehose bad request response a status code equal to that given
+func (o *CreateFirehoseBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *CreateFirehoseBadRequest) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses][%d] createFirehoseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateFirehoseBadRequest) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses][%d] createFirehoseBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *CreateFirehoseBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *CreateFirehoseBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCreateFirehoseConflict creates a CreateFirehoseConflict with default headers values
 func NewCreateFirehoseConflict() *CreateFirehoseConflict {
 	return &CreateFirehoseConflict{}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetFirehoseAlertsParams creates a new GetFirehoseAlertsParams object,
@@ -67,12 +68,42 @@ type GetFirehoseAlertsParams struct {
 	*/
 	FirehoseUrn string
 
+	/* From.
+
+	   Only return alerts triggered at or after this RFC3339 timestamp.
+	*/
+	From *string
+
+	/* PageSize.
+
+	   Maximum number of alerts to return. All alerts are returned if not set.
+	*/
+	PageSize *int64
+
+	/* PageToken.
+
+	   Token returned as next_page_token by a previous call with the same sort and filter parameters, to fetch the next page.
+	*/
+	PageToken *string
+
 	/* ProjectSlug.
 
 	   Unique slug name of the project.
 	*/
 	ProjectSlug string
 
+	/* Severity.
+
+	   Comma separated severities. Only return alerts with one of these severities.
+	*/
+	Severity *string
+
+	/* To.
+
+	   Only return alerts triggered at or before this RFC3339 timestamp.
+	*/
+	To *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -137,6 +168,39 @@ func (o *GetFirehoseAlertsParams) SetFirehoseUrn(firehoseUrn string) {
 	o.FirehoseUrn = firehoseUrn
 }
 
+// WithFrom adds the from to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) WithFrom(from *string) *GetFirehoseAlertsParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) SetFrom(from *string) {
+	o.From = from
+}
+
+// WithPageSize adds the pageSize to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) WithPageSize(pageSize *int64) *GetFirehoseAlertsParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) SetPageSize(pageSize *int64) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) WithPageToken(pageToken *string) *GetFirehoseAlertsParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
 // WithProjectSlug adds the projectSlug to the get firehose alerts params
 func (o *GetFirehoseAlertsParams) WithProjectSlug(projectSlug string) *GetFirehoseAlertsParams {
 	o.SetProjectSlug(projectSlug)
@@ -148,6 +212,28 @@ func (o *GetFirehoseAlertsParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithSeverity adds the severity to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) WithSeverity(severity *string) *GetFirehoseAlertsParams {
+	o.SetSeverity(severity)
+	return o
+}
+
+// SetSeverity adds the severity to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) SetSeverity(severity *string) {
+	o.Severity = severity
+}
+
+// WithTo adds the to to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) WithTo(to *string) *GetFirehoseAlertsParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the get firehose alerts params
+func (o *GetFirehoseAlertsParams) SetTo(to *string) {
+	o.To = to
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetFirehoseAlertsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -161,11 +247,96 @@ func (o *GetFirehoseAlertsParams) WriteToRequest(r runtime.ClientRequest, reg st
 		return err
 	}
 
+	if o.From != nil {
+
+		// query param from
+		var qrFrom string
+
+		if o.From != nil {
+			qrFrom = *o.From
+		}
+		qFrom := qrFrom
+		if qFrom != "" {
+
+			if err := r.SetQueryParam("from", qFrom); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageSize != nil {
+
+		// query param page_size
+		var qrPageSize int64
+
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt64(qrPageSize)
+		if qPageSize != "" {
+
+			if err := r.SetQueryParam("page_size", qPageSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
 	}
 
+	if o.Severity != nil {
+
+		// query param severity
+		var qrSeverity string
+
+		if o.Severity != nil {
+			qrSeverity = *o.Severity
+		}
+		qSeverity := qrSeverity
+		if qSeverity != "" {
+
+			if err := r.SetQueryParam("severity", qSeverity); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.To != nil {
+
+		// query param to
+		var qrTo string
+
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := qrTo
+		if qTo != "" {
+
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -29,6 +29,12 @@ func (o *GetFirehoseAlertsReader) ReadResponse(response runtime.ClientResponse,
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewGetFirehoseAlertsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 404:
 		result := NewGetFirehoseAlertsNotFound()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -109,6 +115,69 @@ func (o *GetFirehoseAlertsOK) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewGetFirehoseAlertsBadRequest creates a GetFirehoseAlertsBadRequest with default headers values
+func NewGetFirehoseAlertsBadRequest() *GetFirehoseAlertsBadRequest {
+	return &GetFirehoseAlertsBadRequest{}
+}
+
+/*
+GetFirehoseAlertsBadRequest describes a response with status code 400, with default header values.
+
+Query parameters are not valid.
+*/
+type GetFirehoseAlertsBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this get firehose alerts bad request response has a 2xx status code
+func (o *GetFirehoseAlertsBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this get firehose alerts bad request response has a 3xx status code
+func (o *GetFirehoseAlertsBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this get firehose alerts bad request response has a 4xx status code
+func (o *GetFirehoseAlertsBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this get firehose alerts bad request response has a 5xx status code
+func (o *GetFirehoseAlertsBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this get firehose alerts bad request response a status code equal to that given
+func (o *GetFirehoseAlertsBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *GetFirehoseAlertsBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/alerts][%d] getFirehoseAlertsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetFirehoseAlertsBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses/{firehoseUrn}/alerts][%d] getFirehoseAlertsBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *GetFirehoseAlertsBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *GetFirehoseAlertsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewGetFirehoseAlertsNotFound creates a GetFirehoseAlertsNotFound with default headers values
 func NewGetFirehoseAlertsNotFound() *GetFirehoseAlertsNotFound {
 	return &GetFirehoseAlertsNotFound{}
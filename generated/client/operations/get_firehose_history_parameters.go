@@ -67,12 +67,54 @@ type GetFirehoseHistoryParams struct {
 	*/
 	FirehoseUrn string
 
+	/* Format.
+
+	   Format of the diffs. Defaults to delta.
+	*/
+	Format *string
+
+	/* From.
+
+	   Revision ID to diff from. Must be used along with 'to'.
+	*/
+	From *string
+
 	/* ProjectSlug.
 
 	   Unique slug name of the project.
 	*/
 	ProjectSlug string
 
+	/* Since.
+
+	   Return only revisions created at or after this RFC3339 timestamp.
+	*/
+	Since *string
+
+	/* To.
+
+	   Revision ID to diff to. Must be used along with 'from'.
+	*/
+	To *string
+
+	/* Until.
+
+	   Return only revisions created at or before this RFC3339 timestamp.
+	*/
+	Until *string
+
+	/* UpdatedBy.
+
+	   Return only revisions made by this user (ID or email).
+	*/
+	UpdatedBy *string
+
+	/* View.
+
+	   Use full to include the spec snapshot of each revision. Defaults to basic.
+	*/
+	View *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -137,6 +179,28 @@ func (o *GetFirehoseHistoryParams) SetFirehoseUrn(firehoseUrn string) {
 	o.FirehoseUrn = firehoseUrn
 }
 
+// WithFormat adds the format to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithFormat(format *string) *GetFirehoseHistoryParams {
+	o.SetFormat(format)
+	return o
+}
+
+// SetFormat adds the format to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetFormat(format *string) {
+	o.Format = format
+}
+
+// WithFrom adds the from to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithFrom(from *string) *GetFirehoseHistoryParams {
+	o.SetFrom(from)
+	return o
+}
+
+// SetFrom adds the from to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetFrom(from *string) {
+	o.From = from
+}
+
 // WithProjectSlug adds the projectSlug to the get firehose history params
 func (o *GetFirehoseHistoryParams) WithProjectSlug(projectSlug string) *GetFirehoseHistoryParams {
 	o.SetProjectSlug(projectSlug)
@@ -148,6 +212,61 @@ func (o *GetFirehoseHistoryParams) SetProjectSlug(projectSlug string) {
 	o.ProjectSlug = projectSlug
 }
 
+// WithSince adds the since to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithSince(since *string) *GetFirehoseHistoryParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetSince(since *string) {
+	o.Since = since
+}
+
+// WithTo adds the to to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithTo(to *string) *GetFirehoseHistoryParams {
+	o.SetTo(to)
+	return o
+}
+
+// SetTo adds the to to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetTo(to *string) {
+	o.To = to
+}
+
+// WithUntil adds the until to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithUntil(until *string) *GetFirehoseHistoryParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetUntil(until *string) {
+	o.Until = until
+}
+
+// WithUpdatedBy adds the updatedBy to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithUpdatedBy(updatedBy *string) *GetFirehoseHistoryParams {
+	o.SetUpdatedBy(updatedBy)
+	return o
+}
+
+// SetUpdatedBy adds the updatedBy to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetUpdatedBy(updatedBy *string) {
+	o.UpdatedBy = updatedBy
+}
+
+// WithView adds the view to the get firehose history params
+func (o *GetFirehoseHistoryParams) WithView(view *string) *GetFirehoseHistoryParams {
+	o.SetView(view)
+	return o
+}
+
+// SetView adds the view to the get firehose history params
+func (o *GetFirehoseHistoryParams) SetView(view *string) {
+	o.View = view
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetFirehoseHistoryParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -161,11 +280,130 @@ func (o *GetFirehoseHistoryParams) WriteToRequest(r runtime.ClientRequest, reg s
 		return err
 	}
 
+	if o.Format != nil {
+
+		// query param format
+		var qrFormat string
+
+		if o.Format != nil {
+			qrFormat = *o.Format
+		}
+		qFormat := qrFormat
+		if qFormat != "" {
+
+			if err := r.SetQueryParam("format", qFormat); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.From != nil {
+
+		// query param from
+		var qrFrom string
+
+		if o.From != nil {
+			qrFrom = *o.From
+		}
+		qFrom := qrFrom
+		if qFrom != "" {
+
+			if err := r.SetQueryParam("from", qFrom); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
 	}
 
+	if o.Since != nil {
+
+		// query param since
+		var qrSince string
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.To != nil {
+
+		// query param to
+		var qrTo string
+
+		if o.To != nil {
+			qrTo = *o.To
+		}
+		qTo := qrTo
+		if qTo != "" {
+
+			if err := r.SetQueryParam("to", qTo); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil string
+
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil
+		if qUntil != "" {
+
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.UpdatedBy != nil {
+
+		// query param updated_by
+		var qrUpdatedBy string
+
+		if o.UpdatedBy != nil {
+			qrUpdatedBy = *o.UpdatedBy
+		}
+		qUpdatedBy := qrUpdatedBy
+		if qUpdatedBy != "" {
+
+			if err := r.SetQueryParam("updated_by", qUpdatedBy); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.View != nil {
+
+		// query param view
+		var qrView string
+
+		if o.View != nil {
+			qrView = *o.View
+		}
+		qView := qrView
+		if qView != "" {
+
+			if err := r.SetQueryParam("view", qView); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -57,6 +57,11 @@ GetFirehoseOK describes a response with status code 200, with default header val
 Found firehose with given URN
 */
 type GetFirehoseOK struct {
+
+	/* Version of the firehose. Send it as If-Match to guard subsequent updates.
+	 */
+	ETag string
+
 	Payload *models.Firehose
 }
 
@@ -99,6 +104,13 @@ func (o *GetFirehoseOK) GetPayload() *models.Firehose {
 
 func (o *GetFirehoseOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.Firehose)
 
 	// response payload
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewListFirehosesParams creates a new ListFirehosesParams object,
@@ -61,11 +62,35 @@ ListFirehosesParams contains all the parameters to send to the API endpoint
 */
 type ListFirehosesParams struct {
 
-	/* Cluster.
+	/* Fields.
 
-	   Return firehoses belonging to only this cluster.
+	   Comma separated list of top-level fields to return for each firehose.
 	*/
-	Cluster *string
+	Fields *string
+
+	/* Group.
+
+	   Return firehoses belonging to only this group.
+	*/
+	Group *string
+
+	/* KubeCluster.
+
+	   Return firehoses belonging to only this kubernetes cluster.
+	*/
+	KubeCluster *string
+
+	/* PageSize.
+
+	   Maximum number of firehoses to return. All firehoses are returned if not set.
+	*/
+	PageSize *int64
+
+	/* PageToken.
+
+	   Token returned as next_page_token by a previous call with the same sort and filter parameters, to fetch the next page.
+	*/
+	PageToken *string
 
 	/* ProjectSlug.
 
@@ -79,6 +104,14 @@ type ListFirehosesParams struct {
 	*/
 	SinkType *string
 
+	/* SortBy.
+
+	     Field to sort the firehoses by. One of name, created_at, updated_at.
+	Prefix with '-' to sort in descending order. Defaults to name.
+
+	*/
+	SortBy *string
+
 	/* Status.
 
 	   Return firehoses only with this status.
@@ -93,18 +126,18 @@ type ListFirehosesParams struct {
 	*/
 	StreamName *string
 
-	/* Team.
-
-	   Return firehoses belonging to only this team.
-	*/
-	Team *string
-
 	/* TopicName.
 
 	   Return firehoses that are consuming from this topic.
 	*/
 	TopicName *string
 
+	/* View.
+
+	   Use full to include configs and state of each firehose. Defaults to basic.
+	*/
+	View *string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -158,15 +191,59 @@ func (o *ListFirehosesParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
-// WithCluster adds the cluster to the list firehoses params
-func (o *ListFirehosesParams) WithCluster(cluster *string) *ListFirehosesParams {
-	o.SetCluster(cluster)
+// WithFields adds the fields to the list firehoses params
+func (o *ListFirehosesParams) WithFields(fields *string) *ListFirehosesParams {
+	o.SetFields(fields)
 	return o
 }
 
-// SetCluster adds the cluster to the list firehoses params
-func (o *ListFirehosesParams) SetCluster(cluster *string) {
-	o.Cluster = cluster
+// SetFields adds the fields to the list firehoses params
+func (o *ListFirehosesParams) SetFields(fields *string) {
+	o.Fields = fields
+}
+
+// WithGroup adds the group to the list firehoses params
+func (o *ListFirehosesParams) WithGroup(group *string) *ListFirehosesParams {
+	o.SetGroup(group)
+	return o
+}
+
+// SetGroup adds the group to the list firehoses params
+func (o *ListFirehosesParams) SetGroup(group *string) {
+	o.Group = group
+}
+
+// WithKubeCluster adds the kubeCluster to the list firehoses params
+func (o *ListFirehosesParams) WithKubeCluster(kubeCluster *string) *ListFirehosesParams {
+	o.SetKubeCluster(kubeCluster)
+	return o
+}
+
+// SetKubeCluster adds the kubeCluster to the list firehoses params
+func (o *ListFirehosesParams) SetKubeCluster(kubeCluster *string) {
+	o.KubeCluster = kubeCluster
+}
+
+// WithPageSize adds the pageSize to the list firehoses params
+func (o *ListFirehosesParams) WithPageSize(pageSize *int64) *ListFirehosesParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the list firehoses params
+func (o *ListFirehosesParams) SetPageSize(pageSize *int64) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the list firehoses params
+func (o *ListFirehosesParams) WithPageToken(pageToken *string) *ListFirehosesParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the list firehoses params
+func (o *ListFirehosesParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
 }
 
 // WithProjectSlug adds the projectSlug to the list firehoses params
@@ -191,6 +268,17 @@ func (o *ListFirehosesParams) SetSinkType(sinkType *string) {
 	o.SinkType = sinkType
 }
 
+// WithSortBy adds the sortBy to the list firehoses params
+func (o *ListFirehosesParams) WithSortBy(sortBy *string) *ListFirehosesParams {
+	o.SetSortBy(sortBy)
+	return o
+}
+
+// SetSortBy adds the sortBy to the list firehoses params
+func (o *ListFirehosesParams) SetSortBy(sortBy *string) {
+	o.SortBy = sortBy
+}
+
 // WithStatus adds the status to the list firehoses params
 func (o *ListFirehosesParams) WithStatus(status *string) *ListFirehosesParams {
 	o.SetStatus(status)
@@ -213,17 +301,6 @@ func (o *ListFirehosesParams) SetStreamName(streamName *string) {
 	o.StreamName = streamName
 }
 
-// WithTeam adds the team to the list firehoses params
-func (o *ListFirehosesParams) WithTeam(team *string) *ListFirehosesParams {
-	o.SetTeam(team)
-	return o
-}
-
-// SetTeam adds the team to the list firehoses params
-func (o *ListFirehosesParams) SetTeam(team *string) {
-	o.Team = team
-}
-
 // WithTopicName adds the topicName to the list firehoses params
 func (o *ListFirehosesParams) WithTopicName(topicName *string) *ListFirehosesParams {
 	o.SetTopicName(topicName)
@@ -235,6 +312,17 @@ func (o *ListFirehosesParams) SetTopicName(topicName *string) {
 	o.TopicName = topicName
 }
 
+// WithView adds the view to the list firehoses params
+func (o *ListFirehosesParams) WithView(view *string) *ListFirehosesParams {
+	o.SetView(view)
+	return o
+}
+
+// SetView adds the view to the list firehoses params
+func (o *ListFirehosesParams) SetView(view *string) {
+	o.View = view
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListFirehosesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -243,18 +331,86 @@ func (o *ListFirehosesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 	}
 	var res []error
 
-	if o.Cluster != nil {
+	if o.Fields != nil {
+
+		// query param fields
+		var qrFields string
+
+		if o.Fields != nil {
+			qrFields = *o.Fields
+		}
+		qFields := qrFields
+		if qFields != "" {
+
+			if err := r.SetQueryParam("fields", qFields); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Group != nil {
+
+		// query param group
+		var qrGroup string
+
+		if o.Group != nil {
+			qrGroup = *o.Group
+		}
+		qGroup := qrGroup
+		if qGroup != "" {
+
+			if err := r.SetQueryParam("group", qGroup); err != nil {
+				return err
+			}
+		}
+	}
 
-		// query param cluster
-		var qrCluster string
+	if o.KubeCluster != nil {
 
-		if o.Cluster != nil {
-			qrCluster = *o.Cluster
+		// query param kube_cluster
+		var qrKubeCluster string
+
+		if o.KubeCluster != nil {
+			qrKubeCluster = *o.KubeCluster
 		}
-		qCluster := qrCluster
-		if qCluster != "" {
+		qKubeCluster := qrKubeCluster
+		if qKubeCluster != "" {
 
-			if err := r.SetQueryParam("cluster", qCluster); err != nil {
+			if err := r.SetQueryParam("kube_cluster", qKubeCluster); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageSize != nil {
+
+		// query param page_size
+		var qrPageSize int64
+
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt64(qrPageSize)
+		if qPageSize != "" {
+
+			if err := r.SetQueryParam("page_size", qPageSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageToken != nil {
+
+		// query param page_token
+		var qrPageToken string
+
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+
+			if err := r.SetQueryParam("page_token", qPageToken); err != nil {
 				return err
 			}
 		}
@@ -282,6 +438,23 @@ func (o *ListFirehosesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
+	if o.SortBy != nil {
+
+		// query param sort_by
+		var qrSortBy string
+
+		if o.SortBy != nil {
+			qrSortBy = *o.SortBy
+		}
+		qSortBy := qrSortBy
+		if qSortBy != "" {
+
+			if err := r.SetQueryParam("sort_by", qSortBy); err != nil {
+				return err
+			}
+		}
+	}
+
 	if o.Status != nil {
 
 		// query param status
@@ -316,35 +489,35 @@ func (o *ListFirehosesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt
 		}
 	}
 
-	if o.Team != nil {
+	if o.TopicName != nil {
 
-		// query param team
-		var qrTeam string
+		// query param topic_name
+		var qrTopicName string
 
-		if o.Team != nil {
-			qrTeam = *o.Team
+		if o.TopicName != nil {
+			qrTopicName = *o.TopicName
 		}
-		qTeam := qrTeam
-		if qTeam != "" {
+		qTopicName := qrTopicName
+		if qTopicName != "" {
 
-			if err := r.SetQueryParam("team", qTeam); err != nil {
+			if err := r.SetQueryParam("topic_name", qTopicName); err != nil {
 				return err
 			}
 		}
 	}
 
-	if o.TopicName != nil {
+	if o.View != nil {
 
-		// query param topic_name
-		var qrTopicName string
+		// query param view
+		var qrView string
 
-		if o.TopicName != nil {
-			qrTopicName = *o.TopicName
+		if o.View != nil {
+			qrView = *o.View
 		}
-		qTopicName := qrTopicName
-		if qTopicName != "" {
+		qView := qrView
+		if qView != "" {
 
-			if err := r.SetQueryParam("topic_name", qTopicName); err != nil {
+			if err := r.SetQueryParam("view", qView); err != nil {
 				return err
 			}
 		}
@@ -29,6 +29,12 @@ func (o *ListFirehosesReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return result, nil
+	case 400:
+		result := NewListFirehosesBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewListFirehosesInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -103,6 +109,69 @@ func (o *ListFirehosesOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewListFirehosesBadRequest creates a ListFirehosesBadRequest with default headers values
+func NewListFirehosesBadRequest() *ListFirehosesBadRequest {
+	return &ListFirehosesBadRequest{}
+}
+
+/*
+ListFirehosesBadRequest describes a response with status code 400, with default header values.
+
+List request is not valid.
+*/
+type ListFirehosesBadRequest struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this list firehoses bad request response has a 2xx status code
+func (o *ListFirehosesBadRequest) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this list firehoses bad request response has a 3xx status code
+func (o *ListFirehosesBadRequest) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this list firehoses bad request response has a 4xx status code
+func (o *ListFirehosesBadRequest) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this list firehoses bad request response has a 5xx status code
+func (o *ListFirehosesBadRequest) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this list firehoses bad request response a status code equal to that given
+func (o *ListFirehosesBadRequest) IsCode(code int) bool {
+	return code == 400
+}
+
+func (o *ListFirehosesBadRequest) Error() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses][%d] listFirehosesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListFirehosesBadRequest) String() string {
+	return fmt.Sprintf("[GET /projects/{projectSlug}/firehoses][%d] listFirehosesBadRequest  %+v", 400, o.Payload)
+}
+
+func (o *ListFirehosesBadRequest) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ListFirehosesBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewListFirehosesInternalServerError creates a ListFirehosesInternalServerError with default headers values
 func NewListFirehosesInternalServerError() *ListFirehosesInternalServerError {
 	return &ListFirehosesInternalServerError{}
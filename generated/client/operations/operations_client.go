@@ -31,12 +31,30 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
-	CreateFirehose(params *CreateFirehoseParams, opts ...ClientOption) (*CreateFirehoseCreated, error)
+	CopyAlertPolicy(params *CopyAlertPolicyParams, opts ...ClientOption) (*CopyAlertPolicyOK, error)
+
+	CreateAlertSilence(params *CreateAlertSilenceParams, opts ...ClientOption) (*CreateAlertSilenceCreated, error)
+
+	CreateFirehose(params *CreateFirehoseParams, opts ...ClientOption) (*CreateFirehoseOK, *CreateFirehoseCreated, error)
+
+	CreateFirehoseSubscription(params *CreateFirehoseSubscriptionParams, opts ...ClientOption) (*CreateFirehoseSubscriptionCreated, error)
+
+	DeleteAlertSilence(params *DeleteAlertSilenceParams, opts ...ClientOption) (*DeleteAlertSilenceNoContent, error)
+
+	DeleteFirehoseAlertRule(params *DeleteFirehoseAlertRuleParams, opts ...ClientOption) (*DeleteFirehoseAlertRuleNoContent, error)
+
+	DeleteFirehoseSubscription(params *DeleteFirehoseSubscriptionParams, opts ...ClientOption) (*DeleteFirehoseSubscriptionNoContent, error)
+
+	DisableFirehoseAlertRule(params *DisableFirehoseAlertRuleParams, opts ...ClientOption) (*DisableFirehoseAlertRuleOK, error)
+
+	EnableFirehoseAlertRule(params *EnableFirehoseAlertRuleParams, opts ...ClientOption) (*EnableFirehoseAlertRuleOK, error)
 
 	GetFirehose(params *GetFirehoseParams, opts ...ClientOption) (*GetFirehoseOK, error)
 
 	GetFirehoseAlertPolicy(params *GetFirehoseAlertPolicyParams, opts ...ClientOption) (*GetFirehoseAlertPolicyOK, error)
 
+	GetFirehoseAlertRule(params *GetFirehoseAlertRuleParams, opts ...ClientOption) (*GetFirehoseAlertRuleOK, error)
+
 	GetFirehoseAlerts(params *GetFirehoseAlertsParams, opts ...ClientOption) (*GetFirehoseAlertsOK, error)
 
 	GetFirehoseHistory(params *GetFirehoseHistoryParams, opts ...ClientOption) (*GetFirehoseHistoryOK, error)
@@ -45,14 +63,34 @@ type ClientService interface {
 
 	GetProjectBySlug(params *GetProjectBySlugParams, opts ...ClientOption) (*GetProjectBySlugOK, error)
 
+	GetSinkTypeSchema(params *GetSinkTypeSchemaParams, opts ...ClientOption) (*GetSinkTypeSchemaOK, error)
+
+	ListAlertReceivers(params *ListAlertReceiversParams, opts ...ClientOption) (*ListAlertReceiversOK, error)
+
+	ListAlertSilences(params *ListAlertSilencesParams, opts ...ClientOption) (*ListAlertSilencesOK, error)
+
 	ListAlertTemplates(params *ListAlertTemplatesParams, opts ...ClientOption) (*ListAlertTemplatesOK, error)
 
+	ListFirehoseSubscriptions(params *ListFirehoseSubscriptionsParams, opts ...ClientOption) (*ListFirehoseSubscriptionsOK, error)
+
 	ListFirehoses(params *ListFirehosesParams, opts ...ClientOption) (*ListFirehosesOK, error)
 
+	ListOrphanedAlertPolicies(params *ListOrphanedAlertPoliciesParams, opts ...ClientOption) (*ListOrphanedAlertPoliciesOK, error)
+
+	ListProjectAlerts(params *ListProjectAlertsParams, opts ...ClientOption) (*ListProjectAlertsOK, error)
+
 	ListProjects(params *ListProjectsParams, opts ...ClientOption) (*ListProjectsOK, error)
 
+	ListSinkTypes(params *ListSinkTypesParams, opts ...ClientOption) (*ListSinkTypesOK, error)
+
+	PatchFirehose(params *PatchFirehoseParams, opts ...ClientOption) (*PatchFirehoseOK, error)
+
+	PurgeOrphanedAlertPolicies(params *PurgeOrphanedAlertPoliciesParams, opts ...ClientOption) (*PurgeOrphanedAlertPoliciesOK, error)
+
 	ResetOffset(params *ResetOffsetParams, opts ...ClientOption) (*ResetOffsetOK, error)
 
+	RollbackFirehose(params *RollbackFirehoseParams, opts ...ClientOption) (*RollbackFirehoseOK, error)
+
 	ScaleFirehose(params *ScaleFirehoseParams, opts ...ClientOption) (*ScaleFirehoseOK, error)
 
 	StartFirehose(params *StartFirehoseParams, opts ...ClientOption) (*StartFirehoseOK, error)
@@ -65,28 +103,631 @@ type ClientService interface {
 
 	UpsertFirehoseAlertPolicy(params *UpsertFirehoseAlertPolicyParams, opts ...ClientOption) (*UpsertFirehoseAlertPolicyOK, error)
 
+	UpsertFirehoseAlertRule(params *UpsertFirehoseAlertRuleParams, opts ...ClientOption) (*UpsertFirehoseAlertRuleOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+CopyAlertPolicy copies an alert policy to firehoses
+
+Apply the alert policy of the source firehose, or the given policy, to the target firehoses selected by URN or by filter. The name, team and entity variables are bound to each target firehose. Failures are reported per target.
+*/
+func (a *Client) CopyAlertPolicy(params *CopyAlertPolicyParams, opts ...ClientOption) (*CopyAlertPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCopyAlertPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "copyAlertPolicy",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/alertPolicy:copy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CopyAlertPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CopyAlertPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for copyAlertPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CreateAlertSilence silences alerts of a firehose
+
+Disable the selected alert rules of a Firehose for the given duration. Rules are enabled again when the silence expires.
+*/
+func (a *Client) CreateAlertSilence(params *CreateAlertSilenceParams, opts ...ClientOption) (*CreateAlertSilenceCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateAlertSilenceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createAlertSilence",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts/silences",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateAlertSilenceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateAlertSilenceCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createAlertSilence: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 CreateFirehose creates a new firehose
 
 Create and deploy a new firehose as per the configurations in the body.
 */
-func (a *Client) CreateFirehose(params *CreateFirehoseParams, opts ...ClientOption) (*CreateFirehoseCreated, error) {
+func (a *Client) CreateFirehose(params *CreateFirehoseParams, opts ...ClientOption) (*CreateFirehoseOK, *CreateFirehoseCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createFirehose",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch value := result.(type) {
+	case *CreateFirehoseOK:
+		return value, nil, nil
+	case *CreateFirehoseCreated:
+		return nil, value, nil
+	}
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for operations: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+CreateFirehoseSubscription routes alerts of a firehose
+
+Create a Siren subscription routing the alerts of the Firehose to the receivers. The subscription always matches the release name of the Firehose.
+*/
+func (a *Client) CreateFirehoseSubscription(params *CreateFirehoseSubscriptionParams, opts ...ClientOption) (*CreateFirehoseSubscriptionCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCreateFirehoseSubscriptionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "createFirehoseSubscription",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts/subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &CreateFirehoseSubscriptionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*CreateFirehoseSubscriptionCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for createFirehoseSubscription: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteAlertSilence ends an alert silence
+
+End an alert silence before it expires. Silenced rules are enabled again.
+*/
+func (a *Client) DeleteAlertSilence(params *DeleteAlertSilenceParams, opts ...ClientOption) (*DeleteAlertSilenceNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteAlertSilenceParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteAlertSilence",
+		Method:             "DELETE",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts/silences/{silenceId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteAlertSilenceReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteAlertSilenceNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteAlertSilence: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteFirehoseAlertRule deletes an alert rule of a firehose
+
+Siren does not support deleting rules. So the rule is disabled instead.
+*/
+func (a *Client) DeleteFirehoseAlertRule(params *DeleteFirehoseAlertRuleParams, opts ...ClientOption) (*DeleteFirehoseAlertRuleNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteFirehoseAlertRuleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteFirehoseAlertRule",
+		Method:             "DELETE",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy/rules/{template}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteFirehoseAlertRuleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteFirehoseAlertRuleNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteFirehoseAlertRule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DeleteFirehoseSubscription deletes an alert subscription of a firehose
+
+Delete a Siren subscription routing the alerts of the Firehose.
+*/
+func (a *Client) DeleteFirehoseSubscription(params *DeleteFirehoseSubscriptionParams, opts ...ClientOption) (*DeleteFirehoseSubscriptionNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDeleteFirehoseSubscriptionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "deleteFirehoseSubscription",
+		Method:             "DELETE",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts/subscriptions/{subscriptionId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DeleteFirehoseSubscriptionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteFirehoseSubscriptionNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for deleteFirehoseSubscription: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+DisableFirehoseAlertRule disables an alert rule of a firehose
+
+Disable the alert rule created from the given template. Rule variables are retained.
+*/
+func (a *Client) DisableFirehoseAlertRule(params *DisableFirehoseAlertRuleParams, opts ...ClientOption) (*DisableFirehoseAlertRuleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDisableFirehoseAlertRuleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "disableFirehoseAlertRule",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy/rules/{template}:disable",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DisableFirehoseAlertRuleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DisableFirehoseAlertRuleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for disableFirehoseAlertRule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+EnableFirehoseAlertRule enables an alert rule of a firehose
+
+Enable the alert rule created from the given template.
+*/
+func (a *Client) EnableFirehoseAlertRule(params *EnableFirehoseAlertRuleParams, opts ...ClientOption) (*EnableFirehoseAlertRuleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewEnableFirehoseAlertRuleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "enableFirehoseAlertRule",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy/rules/{template}:enable",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &EnableFirehoseAlertRuleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*EnableFirehoseAlertRuleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for enableFirehoseAlertRule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehose gets firehose by u r n
+
+Get firehose by URN.
+*/
+func (a *Client) GetFirehose(params *GetFirehoseParams, opts ...ClientOption) (*GetFirehoseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehose",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseAlertPolicy alerts policy for a firehose
+
+Alert policy for a Firehose.
+*/
+func (a *Client) GetFirehoseAlertPolicy(params *GetFirehoseAlertPolicyParams, opts ...ClientOption) (*GetFirehoseAlertPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseAlertPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseAlertPolicy",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseAlertPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseAlertPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseAlertPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseAlertRule alerts rule of a firehose
+
+Alert rule of a Firehose created from the given template.
+*/
+func (a *Client) GetFirehoseAlertRule(params *GetFirehoseAlertRuleParams, opts ...ClientOption) (*GetFirehoseAlertRuleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseAlertRuleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseAlertRule",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy/rules/{template}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseAlertRuleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseAlertRuleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseAlertRule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseAlerts triggereds alerts for a firehose
+
+Triggered alerts for a Firehose, latest first.
+*/
+func (a *Client) GetFirehoseAlerts(params *GetFirehoseAlertsParams, opts ...ClientOption) (*GetFirehoseAlertsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseAlertsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseAlerts",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseAlertsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseAlertsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseAlerts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseHistory histories for a firehose
+
+History for a Firehose.
+*/
+func (a *Client) GetFirehoseHistory(params *GetFirehoseHistoryParams, opts ...ClientOption) (*GetFirehoseHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseHistoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseHistory",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseHistoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseHistoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetFirehoseLogs streams logs for a firehose
+
+Stream logs for a Firehose.
+*/
+func (a *Client) GetFirehoseLogs(params *GetFirehoseLogsParams, writer io.Writer, opts ...ClientOption) (*GetFirehoseLogsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFirehoseLogsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getFirehoseLogs",
+		Method:             "GET",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/logs",
+		ProducesMediaTypes: []string{"application/x-ndjson"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &GetFirehoseLogsReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFirehoseLogsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for getFirehoseLogs: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+GetProjectBySlug gets project by slug
+
+Get project by its unique slug name.
+*/
+func (a *Client) GetProjectBySlug(params *GetProjectBySlugParams, opts ...ClientOption) (*GetProjectBySlugOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewCreateFirehoseParams()
+		params = NewGetProjectBySlugParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "createFirehose",
-		Method:             "POST",
-		PathPattern:        "/projects/{projectSlug}/firehoses",
+		ID:                 "getProjectBySlug",
+		Method:             "GET",
+		PathPattern:        "/projects/{slug}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &CreateFirehoseReader{formats: a.formats},
+		Reader:             &GetProjectBySlugReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -98,35 +739,35 @@ func (a *Client) CreateFirehose(params *CreateFirehoseParams, opts ...ClientOpti
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*CreateFirehoseCreated)
+	success, ok := result.(*GetProjectBySlugOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for createFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for getProjectBySlug: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehose gets firehose by u r n
+GetSinkTypeSchema gets j s o n schema of the sink type
 
-Get firehose by URN.
+Get JSON Schema for validating firehose configs using this sink type.
 */
-func (a *Client) GetFirehose(params *GetFirehoseParams, opts ...ClientOption) (*GetFirehoseOK, error) {
+func (a *Client) GetSinkTypeSchema(params *GetSinkTypeSchemaParams, opts ...ClientOption) (*GetSinkTypeSchemaOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseParams()
+		params = NewGetSinkTypeSchemaParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehose",
+		ID:                 "getSinkTypeSchema",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}",
+		PathPattern:        "/sinkTypes/{sinkType}/schema",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseReader{formats: a.formats},
+		Reader:             &GetSinkTypeSchemaReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -138,35 +779,35 @@ func (a *Client) GetFirehose(params *GetFirehoseParams, opts ...ClientOption) (*
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseOK)
+	success, ok := result.(*GetSinkTypeSchemaOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for getSinkTypeSchema: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehoseAlertPolicy alerts policy for a firehose
+ListAlertReceivers gets list of alert receivers
 
-Alert policy for a Firehose.
+Get list of Siren receivers the alerts of the project can be routed to. Receivers belong to the projects listed in their 'projects' label.
 */
-func (a *Client) GetFirehoseAlertPolicy(params *GetFirehoseAlertPolicyParams, opts ...ClientOption) (*GetFirehoseAlertPolicyOK, error) {
+func (a *Client) ListAlertReceivers(params *ListAlertReceiversParams, opts ...ClientOption) (*ListAlertReceiversOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseAlertPolicyParams()
+		params = NewListAlertReceiversParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehoseAlertPolicy",
+		ID:                 "listAlertReceivers",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy",
+		PathPattern:        "/projects/{projectSlug}/alertReceivers",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseAlertPolicyReader{formats: a.formats},
+		Reader:             &ListAlertReceiversReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -178,35 +819,35 @@ func (a *Client) GetFirehoseAlertPolicy(params *GetFirehoseAlertPolicyParams, op
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseAlertPolicyOK)
+	success, ok := result.(*ListAlertReceiversOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehoseAlertPolicy: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listAlertReceivers: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehoseAlerts triggereds alerts for a firehose
+ListAlertSilences actives alert silences of a firehose
 
-Triggered alerts for a Firehose.
+Active alert silences of a Firehose.
 */
-func (a *Client) GetFirehoseAlerts(params *GetFirehoseAlertsParams, opts ...ClientOption) (*GetFirehoseAlertsOK, error) {
+func (a *Client) ListAlertSilences(params *ListAlertSilencesParams, opts ...ClientOption) (*ListAlertSilencesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseAlertsParams()
+		params = NewListAlertSilencesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehoseAlerts",
+		ID:                 "listAlertSilences",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts/silences",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseAlertsReader{formats: a.formats},
+		Reader:             &ListAlertSilencesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -218,35 +859,35 @@ func (a *Client) GetFirehoseAlerts(params *GetFirehoseAlertsParams, opts ...Clie
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseAlertsOK)
+	success, ok := result.(*ListAlertSilencesOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehoseAlerts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listAlertSilences: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehoseHistory histories for a firehose
+ListAlertTemplates gets list of alert templates for firehose
 
-History for a Firehose.
+Get list of alert templates for firehose.
 */
-func (a *Client) GetFirehoseHistory(params *GetFirehoseHistoryParams, opts ...ClientOption) (*GetFirehoseHistoryOK, error) {
+func (a *Client) ListAlertTemplates(params *ListAlertTemplatesParams, opts ...ClientOption) (*ListAlertTemplatesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseHistoryParams()
+		params = NewListAlertTemplatesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehoseHistory",
+		ID:                 "listAlertTemplates",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/history",
+		PathPattern:        "/alertTemplates",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseHistoryReader{formats: a.formats},
+		Reader:             &ListAlertTemplatesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -258,35 +899,35 @@ func (a *Client) GetFirehoseHistory(params *GetFirehoseHistoryParams, opts ...Cl
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseHistoryOK)
+	success, ok := result.(*ListAlertTemplatesOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehoseHistory: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listAlertTemplates: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetFirehoseLogs streams logs for a firehose
+ListFirehoseSubscriptions alerts subscriptions of a firehose
 
-Stream logs for a Firehose.
+Siren subscriptions routing the alerts of the Firehose, matched by its release name.
 */
-func (a *Client) GetFirehoseLogs(params *GetFirehoseLogsParams, writer io.Writer, opts ...ClientOption) (*GetFirehoseLogsOK, error) {
+func (a *Client) ListFirehoseSubscriptions(params *ListFirehoseSubscriptionsParams, opts ...ClientOption) (*ListFirehoseSubscriptionsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFirehoseLogsParams()
+		params = NewListFirehoseSubscriptionsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getFirehoseLogs",
+		ID:                 "listFirehoseSubscriptions",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/logs",
-		ProducesMediaTypes: []string{"application/x-ndjson"},
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alerts/subscriptions",
+		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetFirehoseLogsReader{formats: a.formats, writer: writer},
+		Reader:             &ListFirehoseSubscriptionsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -298,35 +939,35 @@ func (a *Client) GetFirehoseLogs(params *GetFirehoseLogsParams, writer io.Writer
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFirehoseLogsOK)
+	success, ok := result.(*ListFirehoseSubscriptionsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getFirehoseLogs: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listFirehoseSubscriptions: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-GetProjectBySlug gets project by slug
+ListFirehoses gets list of firehoses
 
-Get project by its unique slug name.
+Get list of firehoses in this project.
 */
-func (a *Client) GetProjectBySlug(params *GetProjectBySlugParams, opts ...ClientOption) (*GetProjectBySlugOK, error) {
+func (a *Client) ListFirehoses(params *ListFirehosesParams, opts ...ClientOption) (*ListFirehosesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetProjectBySlugParams()
+		params = NewListFirehosesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getProjectBySlug",
+		ID:                 "listFirehoses",
 		Method:             "GET",
-		PathPattern:        "/projects/{slug}",
+		PathPattern:        "/projects/{projectSlug}/firehoses",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &GetProjectBySlugReader{formats: a.formats},
+		Reader:             &ListFirehosesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -338,35 +979,35 @@ func (a *Client) GetProjectBySlug(params *GetProjectBySlugParams, opts ...Client
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetProjectBySlugOK)
+	success, ok := result.(*ListFirehosesOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for getProjectBySlug: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listFirehoses: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-ListAlertTemplates gets list of alert templates for firehose
+ListOrphanedAlertPolicies lists orphaned alert policies
 
-Get list of alert templates for firehose.
+List the firehose alert policies in the alert namespace of the project with enabled rules for firehoses that no longer exist. Only the enabled rules are returned.
 */
-func (a *Client) ListAlertTemplates(params *ListAlertTemplatesParams, opts ...ClientOption) (*ListAlertTemplatesOK, error) {
+func (a *Client) ListOrphanedAlertPolicies(params *ListOrphanedAlertPoliciesParams, opts ...ClientOption) (*ListOrphanedAlertPoliciesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListAlertTemplatesParams()
+		params = NewListOrphanedAlertPoliciesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "listAlertTemplates",
+		ID:                 "listOrphanedAlertPolicies",
 		Method:             "GET",
-		PathPattern:        "/alertTemplates",
+		PathPattern:        "/projects/{projectSlug}/orphanedAlertPolicies",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &ListAlertTemplatesReader{formats: a.formats},
+		Reader:             &ListOrphanedAlertPoliciesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -378,35 +1019,35 @@ func (a *Client) ListAlertTemplates(params *ListAlertTemplatesParams, opts ...Cl
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*ListAlertTemplatesOK)
+	success, ok := result.(*ListOrphanedAlertPoliciesOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for listAlertTemplates: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listOrphanedAlertPolicies: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
 /*
-ListFirehoses gets list of firehoses
+ListProjectAlerts triggereds alerts for the firehoses of a project
 
-Get list of firehoses in this project.
+Triggered alerts for all the firehoses of the project, latest first.
 */
-func (a *Client) ListFirehoses(params *ListFirehosesParams, opts ...ClientOption) (*ListFirehosesOK, error) {
+func (a *Client) ListProjectAlerts(params *ListProjectAlertsParams, opts ...ClientOption) (*ListProjectAlertsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListFirehosesParams()
+		params = NewListProjectAlertsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "listFirehoses",
+		ID:                 "listProjectAlerts",
 		Method:             "GET",
-		PathPattern:        "/projects/{projectSlug}/firehoses",
+		PathPattern:        "/projects/{projectSlug}/alerts",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http", "https"},
 		Params:             params,
-		Reader:             &ListFirehosesReader{formats: a.formats},
+		Reader:             &ListProjectAlertsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -418,13 +1059,13 @@ func (a *Client) ListFirehoses(params *ListFirehosesParams, opts ...ClientOption
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*ListFirehosesOK)
+	success, ok := result.(*ListProjectAlertsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
 	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for listFirehoses: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	msg := fmt.Sprintf("unexpected success response for listProjectAlerts: API contract not enforced by server. Client expected to get an error, but got: %T", result)
 	panic(msg)
 }
 
@@ -468,6 +1109,129 @@ func (a *Client) ListProjects(params *ListProjectsParams, opts ...ClientOption)
 	panic(msg)
 }
 
+/*
+ListSinkTypes gets list of sink types
+
+Get list of sink types supported by firehose.
+*/
+func (a *Client) ListSinkTypes(params *ListSinkTypesParams, opts ...ClientOption) (*ListSinkTypesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListSinkTypesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listSinkTypes",
+		Method:             "GET",
+		PathPattern:        "/sinkTypes",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListSinkTypesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListSinkTypesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for listSinkTypes: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PatchFirehose partiallies update firehose
+
+Partially update firehose description and configurations. Body must be a JSON Merge
+Patch (RFC 7396) with content-type `application/merge-patch+json` or a JSON Patch
+(RFC 6902) with content-type `application/json-patch+json`. The patch is applied on
+the document `{"description": ..., "configs": {...}}`.
+*/
+func (a *Client) PatchFirehose(params *PatchFirehoseParams, opts ...ClientOption) (*PatchFirehoseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "patchFirehose",
+		Method:             "PATCH",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/merge-patch+json", "application/json-patch+json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PatchFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PatchFirehoseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for patchFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+PurgeOrphanedAlertPolicies purges orphaned alert policies
+
+Disable all the rules of the orphaned alert policies. Failure to purge a policy does not stop the others from being purged, and is reported in its result.
+*/
+func (a *Client) PurgeOrphanedAlertPolicies(params *PurgeOrphanedAlertPoliciesParams, opts ...ClientOption) (*PurgeOrphanedAlertPoliciesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPurgeOrphanedAlertPoliciesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "purgeOrphanedAlertPolicies",
+		Method:             "DELETE",
+		PathPattern:        "/projects/{projectSlug}/orphanedAlertPolicies",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &PurgeOrphanedAlertPoliciesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PurgeOrphanedAlertPoliciesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for purgeOrphanedAlertPolicies: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ResetOffset resets firehose consumption offset
 
@@ -508,6 +1272,46 @@ func (a *Client) ResetOffset(params *ResetOffsetParams, opts ...ClientOption) (*
 	panic(msg)
 }
 
+/*
+RollbackFirehose rolls back a firehose to a previous revision
+
+Re-apply the configs of the given revision of the firehose.
+*/
+func (a *Client) RollbackFirehose(params *RollbackFirehoseParams, opts ...ClientOption) (*RollbackFirehoseOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRollbackFirehoseParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "rollbackFirehose",
+		Method:             "POST",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/history/{revision}/rollback",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RollbackFirehoseReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RollbackFirehoseOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for rollbackFirehose: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
 ScaleFirehose scales the number of instances of firehose
 
@@ -748,6 +1552,46 @@ func (a *Client) UpsertFirehoseAlertPolicy(params *UpsertFirehoseAlertPolicyPara
 	panic(msg)
 }
 
+/*
+UpsertFirehoseAlertRule upserts an alert rule of a firehose
+
+Create or replace the alert rule created from the given template. Other rules of the alert policy are left untouched.
+*/
+func (a *Client) UpsertFirehoseAlertRule(params *UpsertFirehoseAlertRuleParams, opts ...ClientOption) (*UpsertFirehoseAlertRuleOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpsertFirehoseAlertRuleParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "upsertFirehoseAlertRule",
+		Method:             "PUT",
+		PathPattern:        "/projects/{projectSlug}/firehoses/{firehoseUrn}/alertPolicy/rules/{template}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &UpsertFirehoseAlertRuleReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpsertFirehoseAlertRuleOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for upsertFirehoseAlertRule: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -70,6 +70,12 @@ type ResetOffsetParams struct {
 	*/
 	FirehoseUrn string
 
+	/* IfMatch.
+
+	   ETag of the firehose as last read. Request fails with 412 if the firehose was modified since.
+	*/
+	IfMatch *string
+
 	/* ProjectSlug.
 
 	   Identifier for the project.
@@ -151,6 +157,17 @@ func (o *ResetOffsetParams) SetFirehoseUrn(firehoseUrn string) {
 	o.FirehoseUrn = firehoseUrn
 }
 
+// WithIfMatch adds the ifMatch to the reset offset params
+func (o *ResetOffsetParams) WithIfMatch(ifMatch *string) *ResetOffsetParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the reset offset params
+func (o *ResetOffsetParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithProjectSlug adds the projectSlug to the reset offset params
 func (o *ResetOffsetParams) WithProjectSlug(projectSlug string) *ResetOffsetParams {
 	o.SetProjectSlug(projectSlug)
@@ -178,6 +195,14 @@ func (o *ResetOffsetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.R
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
@@ -46,6 +46,12 @@ func (o *ResetOffsetReader) ReadResponse(response runtime.ClientResponse, consum
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewResetOffsetPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewResetOffsetInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -246,6 +252,69 @@ func (o *ResetOffsetNotFound) readResponse(response runtime.ClientResponse, cons
 	return nil
 }
 
+// NewResetOffsetPreconditionFailed creates a ResetOffsetPreconditionFailed with default headers values
+func NewResetOffsetPreconditionFailed() *ResetOffsetPreconditionFailed {
+	return &ResetOffsetPreconditionFailed{}
+}
+
+/*
+ResetOffsetPreconditionFailed describes a response with status code 412, with default header values.
+
+Firehose was modified since it was last read (If-Match mismatch).
+*/
+type ResetOffsetPreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this reset offset precondition failed response has a 2xx status code
+func (o *ResetOffsetPreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this reset offset precondition failed response has a 3xx status code
+func (o *ResetOffsetPreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this reset offset precondition failed response has a 4xx status code
+func (o *ResetOffsetPreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this reset offset precondition failed response has a 5xx status code
+func (o *ResetOffsetPreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this reset offset precondition failed response a status code equal to that given
+func (o *ResetOffsetPreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+func (o *ResetOffsetPreconditionFailed) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/reset][%d] resetOffsetPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ResetOffsetPreconditionFailed) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/reset][%d] resetOffsetPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ResetOffsetPreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ResetOffsetPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewResetOffsetInternalServerError creates a ResetOffsetInternalServerError with default headers values
 func NewResetOffsetInternalServerError() *ResetOffsetInternalServerError {
 	return &ResetOffsetInternalServerError{}
@@ -45,6 +45,12 @@ func (o *ScaleFirehoseReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewScaleFirehosePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewScaleFirehoseInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -245,6 +251,69 @@ func (o *ScaleFirehoseNotFound) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewScaleFirehosePreconditionFailed creates a ScaleFirehosePreconditionFailed with default headers values
+func NewScaleFirehosePreconditionFailed() *ScaleFirehosePreconditionFailed {
+	return &ScaleFirehosePreconditionFailed{}
+}
+
+/*
+ScaleFirehosePreconditionFailed describes a response with status code 412, with default header values.
+
+Firehose was modified since it was last read (If-Match mismatch).
+*/
+type ScaleFirehosePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this scale firehose precondition failed response has a 2xx status code
+func (o *ScaleFirehosePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this scale firehose precondition failed response has a 3xx status code
+func (o *ScaleFirehosePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this scale firehose precondition failed response has a 4xx status code
+func (o *ScaleFirehosePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this scale firehose precondition failed response has a 5xx status code
+func (o *ScaleFirehosePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this scale firehose precondition failed response a status code equal to that given
+func (o *ScaleFirehosePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+func (o *ScaleFirehosePreconditionFailed) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/scale][%d] scaleFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ScaleFirehosePreconditionFailed) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/scale][%d] scaleFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *ScaleFirehosePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *ScaleFirehosePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewScaleFirehoseInternalServerError creates a ScaleFirehoseInternalServerError with default headers values
 func NewScaleFirehoseInternalServerError() *ScaleFirehoseInternalServerError {
 	return &ScaleFirehoseInternalServerError{}
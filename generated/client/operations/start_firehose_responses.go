@@ -41,6 +41,12 @@ func (o *StartFirehoseReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewStartFirehosePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewStartFirehoseInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -241,6 +247,69 @@ func (o *StartFirehoseNotFound) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewStartFirehosePreconditionFailed creates a StartFirehosePreconditionFailed with default headers values
+func NewStartFirehosePreconditionFailed() *StartFirehosePreconditionFailed {
+	return &StartFirehosePreconditionFailed{}
+}
+
+/*
+StartFirehosePreconditionFailed describes a response with status code 412, with default header values.
+
+Firehose was modified since it was last read (If-Match mismatch).
+*/
+type StartFirehosePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this start firehose precondition failed response has a 2xx status code
+func (o *StartFirehosePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this start firehose precondition failed response has a 3xx status code
+func (o *StartFirehosePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this start firehose precondition failed response has a 4xx status code
+func (o *StartFirehosePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this start firehose precondition failed response has a 5xx status code
+func (o *StartFirehosePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this start firehose precondition failed response a status code equal to that given
+func (o *StartFirehosePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+func (o *StartFirehosePreconditionFailed) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/start][%d] startFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *StartFirehosePreconditionFailed) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/start][%d] startFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *StartFirehosePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *StartFirehosePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewStartFirehoseInternalServerError creates a StartFirehoseInternalServerError with default headers values
 func NewStartFirehoseInternalServerError() *StartFirehoseInternalServerError {
 	return &StartFirehoseInternalServerError{}
@@ -70,6 +70,12 @@ type StopFirehoseParams struct {
 	*/
 	FirehoseUrn string
 
+	/* IfMatch.
+
+	   ETag of the firehose as last read. Request fails with 412 if the firehose was modified since.
+	*/
+	IfMatch *string
+
 	/* ProjectSlug.
 
 	   Identifier for the project.
@@ -151,6 +157,17 @@ func (o *StopFirehoseParams) SetFirehoseUrn(firehoseUrn string) {
 	o.FirehoseUrn = firehoseUrn
 }
 
+// WithIfMatch adds the ifMatch to the stop firehose params
+func (o *StopFirehoseParams) WithIfMatch(ifMatch *string) *StopFirehoseParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the stop firehose params
+func (o *StopFirehoseParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithProjectSlug adds the projectSlug to the stop firehose params
 func (o *StopFirehoseParams) WithProjectSlug(projectSlug string) *StopFirehoseParams {
 	o.SetProjectSlug(projectSlug)
@@ -180,6 +197,14 @@ func (o *StopFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
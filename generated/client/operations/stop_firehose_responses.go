@@ -41,6 +41,12 @@ func (o *StopFirehoseReader) ReadResponse(response runtime.ClientResponse, consu
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewStopFirehosePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewStopFirehoseInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -241,6 +247,69 @@ func (o *StopFirehoseNotFound) readResponse(response runtime.ClientResponse, con
 	return nil
 }
 
+// NewStopFirehosePreconditionFailed creates a StopFirehosePreconditionFailed with default headers values
+func NewStopFirehosePreconditionFailed() *StopFirehosePreconditionFailed {
+	return &StopFirehosePreconditionFailed{}
+}
+
+/*
+StopFirehosePreconditionFailed describes a response with status code 412, with default header values.
+
+Firehose was modified since it was last read (If-Match mismatch).
+*/
+type StopFirehosePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this stop firehose precondition failed response has a 2xx status code
+func (o *StopFirehosePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this stop firehose precondition failed response has a 3xx status code
+func (o *StopFirehosePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this stop firehose precondition failed response has a 4xx status code
+func (o *StopFirehosePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this stop firehose precondition failed response has a 5xx status code
+func (o *StopFirehosePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this stop firehose precondition failed response a status code equal to that given
+func (o *StopFirehosePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+func (o *StopFirehosePreconditionFailed) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/stop][%d] stopFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *StopFirehosePreconditionFailed) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/stop][%d] stopFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *StopFirehosePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *StopFirehosePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewStopFirehoseInternalServerError creates a StopFirehoseInternalServerError with default headers values
 func NewStopFirehoseInternalServerError() *StopFirehoseInternalServerError {
 	return &StopFirehoseInternalServerError{}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewUpdateFirehoseParams creates a new UpdateFirehoseParams object,
@@ -64,12 +65,24 @@ type UpdateFirehoseParams struct {
 	// Body.
 	Body UpdateFirehoseBody
 
+	/* DryRun.
+
+	   Validate and return the would-be firehose along with the diff, without applying the changes.
+	*/
+	DryRun *bool
+
 	/* FirehoseUrn.
 
 	   URN of the firehose.
 	*/
 	FirehoseUrn string
 
+	/* IfMatch.
+
+	   ETag of the firehose as last read. Request fails with 412 if the firehose was modified since.
+	*/
+	IfMatch *string
+
 	/* ProjectSlug.
 
 	   Unique identifier of the project.
@@ -140,6 +153,17 @@ func (o *UpdateFirehoseParams) SetBody(body UpdateFirehoseBody) {
 	o.Body = body
 }
 
+// WithDryRun adds the dryRun to the update firehose params
+func (o *UpdateFirehoseParams) WithDryRun(dryRun *bool) *UpdateFirehoseParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the update firehose params
+func (o *UpdateFirehoseParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
 // WithFirehoseUrn adds the firehoseUrn to the update firehose params
 func (o *UpdateFirehoseParams) WithFirehoseUrn(firehoseUrn string) *UpdateFirehoseParams {
 	o.SetFirehoseUrn(firehoseUrn)
@@ -151,6 +175,17 @@ func (o *UpdateFirehoseParams) SetFirehoseUrn(firehoseUrn string) {
 	o.FirehoseUrn = firehoseUrn
 }
 
+// WithIfMatch adds the ifMatch to the update firehose params
+func (o *UpdateFirehoseParams) WithIfMatch(ifMatch *string) *UpdateFirehoseParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the update firehose params
+func (o *UpdateFirehoseParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithProjectSlug adds the projectSlug to the update firehose params
 func (o *UpdateFirehoseParams) WithProjectSlug(projectSlug string) *UpdateFirehoseParams {
 	o.SetProjectSlug(projectSlug)
@@ -173,11 +208,36 @@ func (o *UpdateFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		return err
 	}
 
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param firehoseUrn
 	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
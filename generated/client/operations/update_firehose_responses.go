@@ -7,6 +7,7 @@ package operations
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 
 	"github.com/odpf/dex/generated/models"
 )
@@ -44,6 +46,12 @@ func (o *UpdateFirehoseReader) ReadResponse(response runtime.ClientResponse, con
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewUpdateFirehosePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewUpdateFirehoseInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -244,6 +252,69 @@ func (o *UpdateFirehoseNotFound) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewUpdateFirehosePreconditionFailed creates a UpdateFirehosePreconditionFailed with default headers values
+func NewUpdateFirehosePreconditionFailed() *UpdateFirehosePreconditionFailed {
+	return &UpdateFirehosePreconditionFailed{}
+}
+
+/*
+UpdateFirehosePreconditionFailed describes a response with status code 412, with default header values.
+
+Firehose was modified since it was last read (If-Match mismatch).
+*/
+type UpdateFirehosePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this update firehose precondition failed response has a 2xx status code
+func (o *UpdateFirehosePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this update firehose precondition failed response has a 3xx status code
+func (o *UpdateFirehosePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this update firehose precondition failed response has a 4xx status code
+func (o *UpdateFirehosePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this update firehose precondition failed response has a 5xx status code
+func (o *UpdateFirehosePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this update firehose precondition failed response a status code equal to that given
+func (o *UpdateFirehosePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+func (o *UpdateFirehosePreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}][%d] updateFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *UpdateFirehosePreconditionFailed) String() string {
+	return fmt.Sprintf("[PUT /projects/{projectSlug}/firehoses/{firehoseUrn}][%d] updateFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *UpdateFirehosePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpdateFirehosePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpdateFirehoseInternalServerError creates a UpdateFirehoseInternalServerError with default headers values
 func NewUpdateFirehoseInternalServerError() *UpdateFirehoseInternalServerError {
 	return &UpdateFirehoseInternalServerError{}
@@ -313,15 +384,27 @@ swagger:model UpdateFirehoseBody
 */
 type UpdateFirehoseBody struct {
 
-	// config
-	Config *models.FirehoseConfig `json:"config,omitempty"`
+	// configs
+	Configs *models.FirehoseConfig `json:"configs,omitempty"`
+
+	// description
+	// Example: This firehose consumes from booking events and ingests to redis
+	Description string `json:"description,omitempty"`
+
+	// Desired run state of the firehose. Current state is retained if not set. Alert rules are disabled and restored as with the stop and start APIs.
+	// Enum: [RUNNING STOPPED]
+	State string `json:"state,omitempty"`
 }
 
 // Validate validates this update firehose body
 func (o *UpdateFirehoseBody) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.validateConfig(formats); err != nil {
+	if err := o.validateConfigs(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateState(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -331,17 +414,17 @@ func (o *UpdateFirehoseBody) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-func (o *UpdateFirehoseBody) validateConfig(formats strfmt.Registry) error {
-	if swag.IsZero(o.Config) { // not required
+func (o *UpdateFirehoseBody) validateConfigs(formats strfmt.Registry) error {
+	if swag.IsZero(o.Configs) { // not required
 		return nil
 	}
 
-	if o.Config != nil {
-		if err := o.Config.Validate(formats); err != nil {
+	if o.Configs != nil {
+		if err := o.Configs.Validate(formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("body" + "." + "config")
+				return ve.ValidateName("body" + "." + "configs")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("body" + "." + "config")
+				return ce.ValidateName("body" + "." + "configs")
 			}
 			return err
 		}
@@ -350,11 +433,53 @@ func (o *UpdateFirehoseBody) validateConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+var updateFirehoseBodyTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["RUNNING","STOPPED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		updateFirehoseBodyTypeStatePropEnum = append(updateFirehoseBodyTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// UpdateFirehoseBodyStateRUNNING captures enum value "RUNNING"
+	UpdateFirehoseBodyStateRUNNING string = "RUNNING"
+
+	// UpdateFirehoseBodyStateSTOPPED captures enum value "STOPPED"
+	UpdateFirehoseBodyStateSTOPPED string = "STOPPED"
+)
+
+// prop value enum
+func (o *UpdateFirehoseBody) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, updateFirehoseBodyTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *UpdateFirehoseBody) validateState(formats strfmt.Registry) error {
+	if swag.IsZero(o.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStateEnum("body"+"."+"state", "body", o.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this update firehose body based on the context it is used
 func (o *UpdateFirehoseBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.contextValidateConfig(ctx, formats); err != nil {
+	if err := o.contextValidateConfigs(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -364,14 +489,14 @@ func (o *UpdateFirehoseBody) ContextValidate(ctx context.Context, formats strfmt
 	return nil
 }
 
-func (o *UpdateFirehoseBody) contextValidateConfig(ctx context.Context, formats strfmt.Registry) error {
+func (o *UpdateFirehoseBody) contextValidateConfigs(ctx context.Context, formats strfmt.Registry) error {
 
-	if o.Config != nil {
-		if err := o.Config.ContextValidate(ctx, formats); err != nil {
+	if o.Configs != nil {
+		if err := o.Configs.ContextValidate(ctx, formats); err != nil {
 			if ve, ok := err.(*errors.Validation); ok {
-				return ve.ValidateName("body" + "." + "config")
+				return ve.ValidateName("body" + "." + "configs")
 			} else if ce, ok := err.(*errors.CompositeError); ok {
-				return ce.ValidateName("body" + "." + "config")
+				return ce.ValidateName("body" + "." + "configs")
 			}
 			return err
 		}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewUpgradeFirehoseParams creates a new UpgradeFirehoseParams object,
@@ -64,12 +65,24 @@ type UpgradeFirehoseParams struct {
 	// Body.
 	Body interface{}
 
+	/* DryRun.
+
+	   Validate and return the would-be firehose along with the diff, without applying the changes.
+	*/
+	DryRun *bool
+
 	/* FirehoseUrn.
 
 	   URN of the firehose.
 	*/
 	FirehoseUrn string
 
+	/* IfMatch.
+
+	   ETag of the firehose as last read. Request fails with 412 if the firehose was modified since.
+	*/
+	IfMatch *string
+
 	/* ProjectSlug.
 
 	   Identifier for the project.
@@ -140,6 +153,17 @@ func (o *UpgradeFirehoseParams) SetBody(body interface{}) {
 	o.Body = body
 }
 
+// WithDryRun adds the dryRun to the upgrade firehose params
+func (o *UpgradeFirehoseParams) WithDryRun(dryRun *bool) *UpgradeFirehoseParams {
+	o.SetDryRun(dryRun)
+	return o
+}
+
+// SetDryRun adds the dryRun to the upgrade firehose params
+func (o *UpgradeFirehoseParams) SetDryRun(dryRun *bool) {
+	o.DryRun = dryRun
+}
+
 // WithFirehoseUrn adds the firehoseUrn to the upgrade firehose params
 func (o *UpgradeFirehoseParams) WithFirehoseUrn(firehoseUrn string) *UpgradeFirehoseParams {
 	o.SetFirehoseUrn(firehoseUrn)
@@ -151,6 +175,17 @@ func (o *UpgradeFirehoseParams) SetFirehoseUrn(firehoseUrn string) {
 	o.FirehoseUrn = firehoseUrn
 }
 
+// WithIfMatch adds the ifMatch to the upgrade firehose params
+func (o *UpgradeFirehoseParams) WithIfMatch(ifMatch *string) *UpgradeFirehoseParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the ifMatch to the upgrade firehose params
+func (o *UpgradeFirehoseParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WithProjectSlug adds the projectSlug to the upgrade firehose params
 func (o *UpgradeFirehoseParams) WithProjectSlug(projectSlug string) *UpgradeFirehoseParams {
 	o.SetProjectSlug(projectSlug)
@@ -175,11 +210,36 @@ func (o *UpgradeFirehoseParams) WriteToRequest(r runtime.ClientRequest, reg strf
 		}
 	}
 
+	if o.DryRun != nil {
+
+		// query param dry_run
+		var qrDryRun bool
+
+		if o.DryRun != nil {
+			qrDryRun = *o.DryRun
+		}
+		qDryRun := swag.FormatBool(qrDryRun)
+		if qDryRun != "" {
+
+			if err := r.SetQueryParam("dry_run", qDryRun); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param firehoseUrn
 	if err := r.SetPathParam("firehoseUrn", o.FirehoseUrn); err != nil {
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+	}
+
 	// path param projectSlug
 	if err := r.SetPathParam("projectSlug", o.ProjectSlug); err != nil {
 		return err
@@ -35,6 +35,12 @@ func (o *UpgradeFirehoseReader) ReadResponse(response runtime.ClientResponse, co
 			return nil, err
 		}
 		return nil, result
+	case 412:
+		result := NewUpgradeFirehosePreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	case 500:
 		result := NewUpgradeFirehoseInternalServerError()
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -172,6 +178,69 @@ func (o *UpgradeFirehoseNotFound) readResponse(response runtime.ClientResponse,
 	return nil
 }
 
+// NewUpgradeFirehosePreconditionFailed creates a UpgradeFirehosePreconditionFailed with default headers values
+func NewUpgradeFirehosePreconditionFailed() *UpgradeFirehosePreconditionFailed {
+	return &UpgradeFirehosePreconditionFailed{}
+}
+
+/*
+UpgradeFirehosePreconditionFailed describes a response with status code 412, with default header values.
+
+Firehose was modified since it was last read (If-Match mismatch).
+*/
+type UpgradeFirehosePreconditionFailed struct {
+	Payload *models.ErrorResponse
+}
+
+// IsSuccess returns true when this upgrade firehose precondition failed response has a 2xx status code
+func (o *UpgradeFirehosePreconditionFailed) IsSuccess() bool {
+	return false
+}
+
+// IsRedirect returns true when this upgrade firehose precondition failed response has a 3xx status code
+func (o *UpgradeFirehosePreconditionFailed) IsRedirect() bool {
+	return false
+}
+
+// IsClientError returns true when this upgrade firehose precondition failed response has a 4xx status code
+func (o *UpgradeFirehosePreconditionFailed) IsClientError() bool {
+	return true
+}
+
+// IsServerError returns true when this upgrade firehose precondition failed response has a 5xx status code
+func (o *UpgradeFirehosePreconditionFailed) IsServerError() bool {
+	return false
+}
+
+// IsCode returns true when this upgrade firehose precondition failed response a status code equal to that given
+func (o *UpgradeFirehosePreconditionFailed) IsCode(code int) bool {
+	return code == 412
+}
+
+func (o *UpgradeFirehosePreconditionFailed) Error() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/upgrade][%d] upgradeFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *UpgradeFirehosePreconditionFailed) String() string {
+	return fmt.Sprintf("[POST /projects/{projectSlug}/firehoses/{firehoseUrn}/upgrade][%d] upgradeFirehosePreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *UpgradeFirehosePreconditionFailed) GetPayload() *models.ErrorResponse {
+	return o.Payload
+}
+
+func (o *UpgradeFirehosePreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ErrorResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpgradeFirehoseInternalServerError creates a UpgradeFirehoseInternalServerError with default headers values
 func NewUpgradeFirehoseInternalServerError() *UpgradeFirehoseInternalServerError {
 	return &UpgradeFirehoseInternalServerError{}
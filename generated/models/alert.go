@@ -41,6 +41,10 @@ type Alert struct {
 	// Format: date-time
 	TriggeredAt strfmt.DateTime `json:"triggered_at,omitempty"`
 
+	// URN of the firehose the alert was triggered for.
+	// Read Only: true
+	Urn string `json:"urn,omitempty"`
+
 	// value
 	Value string `json:"value,omitempty"`
 }
@@ -83,6 +87,10 @@ func (m *Alert) ContextValidate(ctx context.Context, formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateUrn(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -107,6 +115,15 @@ func (m *Alert) contextValidateTriggeredAt(ctx context.Context, formats strfmt.R
 	return nil
 }
 
+func (m *Alert) contextValidateUrn(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "urn", "body", string(m.Urn)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Alert) MarshalBinary() ([]byte, error) {
 	if m == nil {
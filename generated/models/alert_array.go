@@ -21,6 +21,9 @@ type AlertArray struct {
 
 	// items
 	Items []*Alert `json:"items"`
+
+	// Token to fetch the next page. Empty if there are no more pages.
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
 // Validate validates this alert array
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -22,9 +23,12 @@ type ErrorResponse struct {
 
 	// code
 	// Example: internal_error
-	// Enum: [conflict not_found bad_request internal_error]
+	// Enum: [conflict not_found unauthenticated forbidden bad_request precondition_failed unsupported_media_type internal_error]
 	Code string `json:"code,omitempty"`
 
+	// Field-level details of the error, if any.
+	Details []*FieldError `json:"details"`
+
 	// message
 	// Example: Something went wrong
 	Message string `json:"message,omitempty"`
@@ -38,6 +42,10 @@ func (m *ErrorResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDetails(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -48,7 +56,7 @@ var errorResponseTypeCodePropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["conflict","not_found","bad_request","internal_error"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["conflict","not_found","unauthenticated","forbidden","bad_request","precondition_failed","unsupported_media_type","internal_error"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -64,9 +72,21 @@ const (
 	// ErrorResponseCodeNotFound captures enum value "not_found"
 	ErrorResponseCodeNotFound string = "not_found"
 
+	// ErrorResponseCodeUnauthenticated captures enum value "unauthenticated"
+	ErrorResponseCodeUnauthenticated string = "unauthenticated"
+
+	// ErrorResponseCodeForbidden captures enum value "forbidden"
+	ErrorResponseCodeForbidden string = "forbidden"
+
 	// ErrorResponseCodeBadRequest captures enum value "bad_request"
 	ErrorResponseCodeBadRequest string = "bad_request"
 
+	// ErrorResponseCodePreconditionFailed captures enum value "precondition_failed"
+	ErrorResponseCodePreconditionFailed string = "precondition_failed"
+
+	// ErrorResponseCodeUnsupportedMediaType captures enum value "unsupported_media_type"
+	ErrorResponseCodeUnsupportedMediaType string = "unsupported_media_type"
+
 	// ErrorResponseCodeInternalError captures enum value "internal_error"
 	ErrorResponseCodeInternalError string = "internal_error"
 )
@@ -92,8 +112,63 @@ func (m *ErrorResponse) validateCode(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this error response based on context it is used
+func (m *ErrorResponse) validateDetails(formats strfmt.Registry) error {
+	if swag.IsZero(m.Details) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Details); i++ {
+		if swag.IsZero(m.Details[i]) { // not required
+			continue
+		}
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this error response based on the context it is used
 func (m *ErrorResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDetails(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ErrorResponse) contextValidateDetails(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Details); i++ {
+
+		if m.Details[i] != nil {
+			if err := m.Details[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("details" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("details" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
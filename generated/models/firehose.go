@@ -32,10 +32,16 @@ type Firehose struct {
 	// Format: date-time
 	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
 
+	// default alerts
+	DefaultAlerts *DefaultAlertsReport `json:"default_alerts,omitempty"`
+
 	// description
 	// Example: This firehose consumes from booking events and ingests to redis
 	Description string `json:"description,omitempty"`
 
+	// dry run
+	DryRun *DryRunInfo `json:"dry_run,omitempty"`
+
 	// name
 	// Example: booking-events-ingester
 	Name string `json:"name,omitempty"`
@@ -76,6 +82,14 @@ func (m *Firehose) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateDefaultAlerts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDryRun(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateState(formats); err != nil {
 		res = append(res, err)
 	}
@@ -121,6 +135,44 @@ func (m *Firehose) validateCreatedAt(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Firehose) validateDefaultAlerts(formats strfmt.Registry) error {
+	if swag.IsZero(m.DefaultAlerts) { // not required
+		return nil
+	}
+
+	if m.DefaultAlerts != nil {
+		if err := m.DefaultAlerts.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("default_alerts")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("default_alerts")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Firehose) validateDryRun(formats strfmt.Registry) error {
+	if swag.IsZero(m.DryRun) { // not required
+		return nil
+	}
+
+	if m.DryRun != nil {
+		if err := m.DryRun.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dry_run")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dry_run")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Firehose) validateState(formats strfmt.Registry) error {
 	if swag.IsZero(m.State) { // not required
 		return nil
@@ -164,6 +216,14 @@ func (m *Firehose) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateDefaultAlerts(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDryRun(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateState(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -211,6 +271,38 @@ func (m *Firehose) contextValidateCreatedAt(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *Firehose) contextValidateDefaultAlerts(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DefaultAlerts != nil {
+		if err := m.DefaultAlerts.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("default_alerts")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("default_alerts")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Firehose) contextValidateDryRun(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.DryRun != nil {
+		if err := m.DryRun.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("dry_run")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("dry_run")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Firehose) contextValidateState(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.State != nil {
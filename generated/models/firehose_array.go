@@ -21,6 +21,9 @@ type FirehoseArray struct {
 
 	// items
 	Items []*Firehose `json:"items"`
+
+	// Token to fetch the next page. Empty if there are no more pages.
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
 // Validate validates this firehose array
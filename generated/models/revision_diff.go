@@ -19,12 +19,26 @@ import (
 // swagger:model RevisionDiff
 type RevisionDiff struct {
 
-	// diff
+	// ID of the revision the diff is computed against. Empty for the first revision.
+	// Example: 1
+	// Read Only: true
+	BaseID string `json:"base_id,omitempty"`
+
+	// Diff of the spec against the base revision. JSON document for delta and json_patch
+	// formats, string for unified format.
 	Diff interface{} `json:"diff,omitempty"`
 
+	// id
+	// Example: 1
+	// Read Only: true
+	ID string `json:"id,omitempty"`
+
 	// labels
 	Labels interface{} `json:"labels,omitempty"`
 
+	// Spec snapshot of the revision. Set only for full view.
+	Spec interface{} `json:"spec,omitempty"`
+
 	// updated at
 	// Example: 2022-06-23T16:49:15.885541Z
 	// Read Only: true
@@ -62,6 +76,14 @@ func (m *RevisionDiff) validateUpdatedAt(formats strfmt.Registry) error {
 func (m *RevisionDiff) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateBaseID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateUpdatedAt(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -72,6 +94,24 @@ func (m *RevisionDiff) ContextValidate(ctx context.Context, formats strfmt.Regis
 	return nil
 }
 
+func (m *RevisionDiff) contextValidateBaseID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "base_id", "body", string(m.BaseID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *RevisionDiff) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", string(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *RevisionDiff) contextValidateUpdatedAt(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "updated_at", "body", strfmt.DateTime(m.UpdatedAt)); err != nil {
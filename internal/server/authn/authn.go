@@ -0,0 +1,130 @@
+package authn
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	gorillamux "github.com/gorilla/mux"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	headerAuthorization = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+var validMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+
+// Config configures the bearer-token authentication of API requests. When
+// enabled, every request must carry a JWT signed by one of the configured
+// keys and user identity is taken from its claims instead of the headers
+// injected by the Shield proxy.
+type Config struct {
+	Enabled  bool   `mapstructure:"enabled" default:"false"`
+	Issuer   string `mapstructure:"issuer"`
+	Audience string `mapstructure:"audience"`
+
+	// JWKSURL is the URL to fetch the signing keys from. Keys are fetched
+	// again when a token signed with an unknown key is seen.
+	JWKSURL string `mapstructure:"jwks_url"`
+
+	// KeyFiles are local PEM encoded public keys or JWKS documents. Used
+	// instead of JWKSURL when set (e.g., for testing).
+	KeyFiles []string `mapstructure:"key_files"`
+
+	EmailClaim  string        `mapstructure:"email_claim" default:"email"`
+	UserIDClaim string        `mapstructure:"user_id_claim" default:"sub"`
+	Leeway      time.Duration `mapstructure:"leeway" default:"30s"`
+}
+
+type authenticator struct {
+	cfg  Config
+	keys keySet
+}
+
+// Middleware returns a middleware that authenticates requests using the
+// bearer-token and populates the request context with the user identity
+// from the token claims. Requests are passed through untouched if the
+// authentication is not enabled.
+func Middleware(cfg Config) (gorillamux.MiddlewareFunc, error) {
+	if !cfg.Enabled {
+		return func(next http.Handler) http.Handler { return next }, nil
+	}
+
+	keys, err := newKeySet(cfg)
+	if err != nil {
+		return nil, err
+	}
+	authn := &authenticator{cfg: cfg, keys: keys}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, err := authn.authenticate(r)
+			if err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+
+			rCtx := reqctx.From(r.Context())
+			rCtx.UserID, _ = claims[cfg.UserIDClaim].(string)
+			rCtx.UserEmail, _ = claims[cfg.EmailClaim].(string)
+			next.ServeHTTP(w, r.WithContext(reqctx.With(r.Context(), rCtx)))
+		})
+	}, nil
+}
+
+func (authn *authenticator) authenticate(r *http.Request) (jwt.MapClaims, error) {
+	authz := strings.TrimSpace(r.Header.Get(headerAuthorization))
+	if !strings.HasPrefix(authz, bearerPrefix) {
+		return nil, errors.ErrUnauthenticated.
+			WithMsgf("bearer token is required").
+			WithCausef("no bearer token in %s header", headerAuthorization)
+	}
+	rawToken := strings.TrimSpace(strings.TrimPrefix(authz, bearerPrefix))
+
+	claims := jwt.MapClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods(validMethods), jwt.WithoutClaimsValidation())
+	_, err := parser.ParseWithClaims(rawToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return authn.keys.lookup(r.Context(), kid)
+	})
+	if err != nil {
+		return nil, errors.ErrUnauthenticated.
+			WithMsgf("bearer token is not valid").
+			WithCausef(err.Error())
+	}
+
+	if err := authn.validateClaims(claims, time.Now()); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func (authn *authenticator) validateClaims(claims jwt.MapClaims, now time.Time) error {
+	invalid := func(reason string) error {
+		return errors.ErrUnauthenticated.
+			WithMsgf("bearer token is not valid").
+			WithCausef(reason)
+	}
+
+	leeway := authn.cfg.Leeway
+	if !claims.VerifyExpiresAt(now.Add(-leeway).Unix(), true) {
+		return invalid("token is expired or has no expiry")
+	} else if !claims.VerifyNotBefore(now.Add(leeway).Unix(), false) {
+		return invalid("token is not valid yet")
+	} else if authn.cfg.Issuer != "" && !claims.VerifyIssuer(authn.cfg.Issuer, true) {
+		return invalid("issuer does not match")
+	} else if authn.cfg.Audience != "" && !claims.VerifyAudience(authn.cfg.Audience, true) {
+		return invalid("audience does not match")
+	}
+
+	if email, _ := claims[authn.cfg.EmailClaim].(string); email == "" {
+		return invalid("token has no '" + authn.cfg.EmailClaim + "' claim")
+	}
+	return nil
+}
@@ -0,0 +1,141 @@
+package authn
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "test-key.pem")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	mw, err := Middleware(Config{
+		Enabled:     true,
+		Issuer:      "https://issuer.example.com",
+		Audience:    "dex",
+		KeyFiles:    []string{keyFile},
+		EmailClaim:  "email",
+		UserIDClaim: "sub",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var gotCtx reqctx.ReqCtx
+	handler := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotCtx = reqctx.From(r.Context())
+	}))
+
+	sign := func(claims jwt.MapClaims) string {
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+		token.Header["kid"] = "test-key"
+		s, err := token.SignedString(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	validClaims := func() jwt.MapClaims {
+		return jwt.MapClaims{
+			"iss":   "https://issuer.example.com",
+			"aud":   "dex",
+			"sub":   "user-1",
+			"email": "john@example.com",
+			"exp":   time.Now().Add(time.Hour).Unix(),
+		}
+	}
+
+	table := []struct {
+		title      string
+		authz      func() string
+		wantStatus int
+	}{
+		{
+			title:      "MissingToken",
+			authz:      func() string { return "" },
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			title: "ExpiredToken",
+			authz: func() string {
+				claims := validClaims()
+				claims["exp"] = time.Now().Add(-time.Hour).Unix()
+				return bearerPrefix + sign(claims)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			title: "WrongAudience",
+			authz: func() string {
+				claims := validClaims()
+				claims["aud"] = "other"
+				return bearerPrefix + sign(claims)
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			title: "UnsignedToken",
+			authz: func() string {
+				s, _ := jwt.NewWithClaims(jwt.SigningMethodNone, validClaims()).SignedString(jwt.UnsafeAllowNoneSignatureType)
+				return bearerPrefix + s
+			},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			title:      "Success",
+			authz:      func() string { return bearerPrefix + sign(validClaims()) },
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			gotCtx = reqctx.ReqCtx{}
+
+			req := httptest.NewRequest(http.MethodGet, "/api/projects", nil)
+			if authz := tt.authz(); authz != "" {
+				req.Header.Set(headerAuthorization, authz)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+
+			if tt.wantStatus == http.StatusOK {
+				if gotCtx.UserEmail != "john@example.com" || gotCtx.UserID != "user-1" {
+					t.Errorf("reqctx = %+v, want identity from claims", gotCtx)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package authn
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// minRefreshInterval limits how often the JWKS is re-fetched when tokens
+// signed with unknown keys are seen.
+const minRefreshInterval = 1 * time.Minute
+
+type keySet interface {
+	lookup(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+func newKeySet(cfg Config) (keySet, error) {
+	if len(cfg.KeyFiles) > 0 {
+		return loadKeyFiles(cfg.KeyFiles)
+	} else if cfg.JWKSURL == "" {
+		return nil, errors.New("one of jwks_url or key_files must be set for authentication")
+	}
+
+	return &remoteKeySet{
+		url:    cfg.JWKSURL,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}, nil
+}
+
+// staticKeySet maps key-id to the public key.
+type staticKeySet map[string]crypto.PublicKey
+
+func (ks staticKeySet) lookup(_ context.Context, kid string) (crypto.PublicKey, error) {
+	if key, found := ks[kid]; found {
+		return key, nil
+	} else if kid == "" && len(ks) == 1 {
+		for _, key := range ks {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("no key with id '%s'", kid)
+}
+
+type remoteKeySet struct {
+	url    string
+	client *http.Client
+
+	mu        sync.Mutex
+	keys      staticKeySet
+	fetchedAt time.Time
+}
+
+func (ks *remoteKeySet) lookup(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	if ks.keys != nil {
+		key, err := ks.keys.lookup(ctx, kid)
+		if err == nil || time.Since(ks.fetchedAt) < minRefreshInterval {
+			return key, err
+		}
+	}
+
+	keys, err := ks.fetch(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ks.keys, ks.fetchedAt = keys, time.Now()
+
+	return ks.keys.lookup(ctx, kid)
+}
+
+func (ks *remoteKeySet) fetch(ctx context.Context) (staticKeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := ks.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch jwks: status %d", resp.StatusCode)
+	}
+
+	var doc jwks
+	if err := json.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("failed to decode jwks: %w", err)
+	}
+	return doc.keySet()
+}
+
+// loadKeyFiles loads keys from PEM or JWKS files. Keys from PEM files
+// use the file name (without extension) as the key-id.
+func loadKeyFiles(files []string) (staticKeySet, error) {
+	keys := staticKeySet{}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+			var doc jwks
+			if err := json.Unmarshal(data, &doc); err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+
+			fileKeys, err := doc.keySet()
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", file, err)
+			}
+			for kid, key := range fileKeys {
+				keys[kid] = key
+			}
+			continue
+		}
+
+		key, err := parsePEMKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		kid := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+		keys[kid] = key
+	}
+	return keys, nil
+}
+
+func parsePEMKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	switch block.Type {
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported PEM block '%s'", block.Type)
+	}
+}
+
+// jwks is a JSON Web Key Set as per RFC 7517. Only RSA and EC signing
+// keys are supported.
+type jwks struct {
+	Keys []jwk `json:"keys"`
+}
+
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+func (doc jwks) keySet() (staticKeySet, error) {
+	keys := staticKeySet{}
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		key, err := k.publicKey()
+		if err != nil {
+			return nil, fmt.Errorf("key '%s': %w", k.Kid, err)
+		} else if key != nil {
+			keys[k.Kid] = key
+		}
+	}
+	return keys, nil
+}
+
+// publicKey returns the public key. Returns nil if the key-type is not
+// supported.
+func (k jwk) publicKey() (crypto.PublicKey, error) {
+	decodeInt := func(s string) (*big.Int, error) {
+		b, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(s, "="))
+		if err != nil {
+			return nil, err
+		} else if len(b) == 0 {
+			return nil, errors.New("missing key parameter")
+		}
+		return new(big.Int).SetBytes(b), nil
+	}
+
+	switch k.Kty {
+	case "RSA":
+		n, err := decodeInt(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeInt(k.E)
+		if err != nil {
+			return nil, err
+		}
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+
+	case "EC":
+		curves := map[string]elliptic.Curve{
+			"P-256": elliptic.P256(),
+			"P-384": elliptic.P384(),
+			"P-521": elliptic.P521(),
+		}
+		curve, found := curves[k.Crv]
+		if !found {
+			return nil, fmt.Errorf("unsupported curve '%s'", k.Crv)
+		}
+
+		x, err := decodeInt(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeInt(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+
+	default:
+		return nil, nil
+	}
+}
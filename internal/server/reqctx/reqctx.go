@@ -16,6 +16,11 @@ func withReqCtx(ctx context.Context, reqCtx ReqCtx) context.Context {
 	return context.WithValue(ctx, reqCtxKey, reqCtx)
 }
 
+// With returns a copy of the go context with the given ReqCtx.
+func With(ctx context.Context, reqCtx ReqCtx) context.Context {
+	return withReqCtx(ctx, reqCtx)
+}
+
 // From returns the ReqCtx from the given go context. Returns zero-value
 // if not available.
 func From(ctx context.Context) ReqCtx {
@@ -12,9 +12,12 @@ import (
 	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
 	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
 	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+	"go.opencensus.io/stats/view"
 	"go.uber.org/zap"
 
+	"github.com/odpf/dex/internal/server/authn"
 	"github.com/odpf/dex/internal/server/reqctx"
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
 	firehosesv1 "github.com/odpf/dex/internal/server/v1/firehose"
 	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
 )
@@ -22,11 +25,27 @@ import (
 // Serve initialises all the HTTP API routes, starts listening for requests at addr, and blocks until
 // server exits. Server exits gracefully when context is cancelled.
 func Serve(ctx context.Context, addr string, nrApp *newrelic.Application, logger *zap.Logger,
-	latestFirehoseVersion string,
+	latestFirehoseVersion string, authnCfg authn.Config, authzCfg firehosesv1.AuthzConfig,
+	projectCacheCfg projectsv1.CacheConfig, namespaceCacheCfg alertsv1.NamespaceCacheConfig,
 	shieldClient shieldv1beta1.ShieldServiceClient,
 	entropyClient entropyv1beta1.ResourceServiceClient,
 	sirenClient sirenv1beta1.SirenServiceClient,
 ) error {
+	authnMiddleware, err := authn.Middleware(authnCfg)
+	if err != nil {
+		return err
+	}
+
+	if err := view.Register(projectsv1.CacheViews...); err != nil {
+		return err
+	}
+	projects := projectsv1.NewResolver(shieldClient, projectCacheCfg)
+
+	alertSvc := alertsv1.NewService(sirenClient, namespaceCacheCfg)
+	go alertSvc.RefreshNamespaces(ctx)
+	go firehosesv1.ExpireAlertSilences(ctx, entropyClient, alertSvc, logger)
+	go firehosesv1.ApplyPendingDefaultAlerts(ctx, entropyClient, projects, alertSvc, logger)
+
 	httpRouter := gorillamux.NewRouter()
 	httpRouter.Use(nrgorilla.Middleware(nrApp))
 	httpRouter.Handle("/ping", http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
@@ -42,8 +61,9 @@ func Serve(ctx context.Context, addr string, nrApp *newrelic.Application, logger
 
 	// Setup API routes. Refer swagger.yml
 	apiRouter := httpRouter.PathPrefix("/api/").Subrouter()
-	projectsv1.Routes(apiRouter, shieldClient)
-	firehosesv1.Routes(apiRouter, entropyClient, shieldClient, sirenClient, latestFirehoseVersion)
+	apiRouter.Use(authnMiddleware)
+	projectsv1.Routes(apiRouter, shieldClient, projects)
+	firehosesv1.Routes(apiRouter, entropyClient, shieldClient, projects, alertSvc, latestFirehoseVersion, authzCfg)
 
 	logger.Info("starting server", zap.String("addr", addr))
 	return mux.Serve(ctx, addr, mux.WithHTTP(httpRouter))
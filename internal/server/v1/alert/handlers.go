@@ -8,6 +8,7 @@ import (
 
 const (
 	alertPolicyNotFound      = "no Alert Policy found for given resource"
+	alertRuleNotFound        = "no Alert Rule found for template '%s'"
 	alertProviderName        = "cortex"
 	projectSlugSirenLabelKey = "projects"
 )
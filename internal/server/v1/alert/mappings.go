@@ -2,9 +2,12 @@ package alert
 
 import (
 	"strconv"
+	"strings"
 	"time"
 
 	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+
+	"github.com/odpf/dex/pkg/errors"
 )
 
 const defaultDecimalBase = 10
@@ -41,6 +44,31 @@ type Alert struct {
 	TriggeredAt time.Time `json:"triggered_at"`
 }
 
+// AlertFilter selects the alerts to be listed. Zero values match all.
+type AlertFilter struct {
+	From       time.Time
+	To         time.Time
+	Severities []string
+}
+
+func (f AlertFilter) matches(a Alert) bool {
+	if !f.From.IsZero() && a.TriggeredAt.Before(f.From) {
+		return false
+	} else if !f.To.IsZero() && a.TriggeredAt.After(f.To) {
+		return false
+	}
+
+	if len(f.Severities) == 0 {
+		return true
+	}
+	for _, severity := range f.Severities {
+		if strings.EqualFold(severity, a.Severity) {
+			return true
+		}
+	}
+	return false
+}
+
 type Template struct {
 	ID        string     `json:"id"`
 	Name      string     `json:"name"`
@@ -51,6 +79,32 @@ type Template struct {
 	Variables []Variable `json:"variables"`
 }
 
+// Subscription routes the alerts matching its labels to the receivers.
+type Subscription struct {
+	ID        string                 `json:"id"`
+	URN       string                 `json:"urn"`
+	Receivers []SubscriptionReceiver `json:"receivers"`
+	Match     map[string]string      `json:"match"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// SubscriptionReceiver is a receiver of the subscription along with the
+// receiver configuration specific to the subscription (e.g., slack channel).
+type SubscriptionReceiver struct {
+	ID            string            `json:"id"`
+	Configuration map[string]string `json:"configuration,omitempty"`
+}
+
+type Receiver struct {
+	ID        string            `json:"id"`
+	Name      string            `json:"name"`
+	Type      string            `json:"type"`
+	Labels    map[string]string `json:"labels"`
+	CreatedAt time.Time         `json:"created_at"`
+	UpdatedAt time.Time         `json:"updated_at"`
+}
+
 type namespace struct {
 	ID          uint64                 `json:"id"`
 	URN         string                 `json:"urn"`
@@ -79,19 +133,15 @@ func mapRulesToAlertPolicy(rules []*sirenv1beta1.Rule) []Policy {
 	return policies
 }
 
-func mapAlertPolicyToUpdateRulesRequest(p Policy, providerNamespace uint64) []*sirenv1beta1.UpdateRuleRequest {
-	var rules []*sirenv1beta1.UpdateRuleRequest
-	for _, r := range p.Rules {
-		rules = append(rules, &sirenv1beta1.UpdateRuleRequest{
-			GroupName:         r.Template,
-			Namespace:         p.Resource,
-			Template:          r.Template,
-			ProviderNamespace: providerNamespace,
-			Enabled:           r.Enabled,
-			Variables:         mapVariablesToProtoRuleVariables(r.Variables),
-		})
+func mapRuleToUpdateRuleRequest(resource string, r Rule, providerNamespace uint64) *sirenv1beta1.UpdateRuleRequest {
+	return &sirenv1beta1.UpdateRuleRequest{
+		GroupName:         r.Template,
+		Namespace:         resource,
+		Template:          r.Template,
+		ProviderNamespace: providerNamespace,
+		Enabled:           r.Enabled,
+		Variables:         mapVariablesToProtoRuleVariables(r.Variables),
 	}
-	return rules
 }
 
 func mapProtoRuleToRule(r *sirenv1beta1.Rule) (string, Rule) {
@@ -192,3 +242,57 @@ func mapProtoNamespaceToNamespace(ns *sirenv1beta1.Namespace) *namespace {
 		UpdatedAt:   ns.GetUpdatedAt().AsTime(),
 	}
 }
+
+func mapProtoSubscriptionToSubscription(sub *sirenv1beta1.Subscription) Subscription {
+	receivers := []SubscriptionReceiver{}
+	for _, r := range sub.GetReceivers() {
+		receivers = append(receivers, SubscriptionReceiver{
+			ID:            strconv.FormatUint(r.GetId(), defaultDecimalBase),
+			Configuration: r.GetConfiguration(),
+		})
+	}
+
+	return Subscription{
+		ID:        strconv.FormatUint(sub.GetId(), defaultDecimalBase),
+		URN:       sub.GetUrn(),
+		Receivers: receivers,
+		Match:     sub.GetMatch(),
+		CreatedAt: sub.GetCreatedAt().AsTime(),
+		UpdatedAt: sub.GetUpdatedAt().AsTime(),
+	}
+}
+
+func mapSubscriptionToCreateSubscriptionRequest(sub Subscription, namespace uint64) (*sirenv1beta1.CreateSubscriptionRequest, error) {
+	var receivers []*sirenv1beta1.ReceiverMetadata
+	for _, r := range sub.Receivers {
+		id, err := strconv.ParseUint(r.ID, defaultDecimalBase, 64)
+		if err != nil {
+			return nil, errors.ErrInvalid.
+				WithMsgf("receiver id must be a number").
+				WithCausef("invalid receiver id '%s'", r.ID)
+		}
+
+		receivers = append(receivers, &sirenv1beta1.ReceiverMetadata{
+			Id:            id,
+			Configuration: r.Configuration,
+		})
+	}
+
+	return &sirenv1beta1.CreateSubscriptionRequest{
+		Urn:       sub.URN,
+		Namespace: namespace,
+		Receivers: receivers,
+		Match:     sub.Match,
+	}, nil
+}
+
+func mapProtoReceiverToReceiver(r *sirenv1beta1.Receiver) Receiver {
+	return Receiver{
+		ID:        strconv.FormatUint(r.GetId(), defaultDecimalBase),
+		Name:      r.GetName(),
+		Type:      r.GetType(),
+		Labels:    r.GetLabels(),
+		CreatedAt: r.GetCreatedAt().AsTime(),
+		UpdatedAt: r.GetUpdatedAt().AsTime(),
+	}
+}
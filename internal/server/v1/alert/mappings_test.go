@@ -0,0 +1,22 @@
+package alert
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAlertFilter_matches(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	alert := Alert{Severity: "CRITICAL", TriggeredAt: now}
+
+	assert.True(t, AlertFilter{}.matches(alert))
+	assert.True(t, AlertFilter{From: now.Add(-time.Hour), To: now, Severities: []string{"warning", "critical"}}.matches(alert))
+
+	assert.False(t, AlertFilter{From: now.Add(time.Second)}.matches(alert))
+	assert.False(t, AlertFilter{To: now.Add(-time.Second)}.matches(alert))
+	assert.False(t, AlertFilter{Severities: []string{"WARNING"}}.matches(alert))
+}
@@ -0,0 +1,115 @@
+package alert
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+	"golang.org/x/sync/singleflight"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// minMissRefreshInterval limits how often the index is rebuilt when a
+// project without any namespace is looked up.
+const minMissRefreshInterval = 10 * time.Second
+
+// NamespaceCacheConfig configures the caching of Siren namespaces.
+type NamespaceCacheConfig struct {
+	// RefreshInterval is the interval at which the project to namespace
+	// index is rebuilt. Caching is disabled if this is not positive.
+	RefreshInterval time.Duration `mapstructure:"refresh_interval" default:"5m"`
+}
+
+// namespaceIndex maps project slugs to the Siren namespaces labelled with
+// them.
+type namespaceIndex struct {
+	siren  sirenv1beta1.SirenServiceClient
+	maxAge time.Duration
+	group  singleflight.Group
+
+	mu        sync.RWMutex
+	byProject map[string][]*namespace
+	builtAt   time.Time
+}
+
+func (idx *namespaceIndex) get(ctx context.Context, projectSlug string) (*namespace, error) {
+	namespaces, fresh := idx.lookup(projectSlug)
+	if !fresh {
+		var err error
+		namespaces, err = idx.refresh(ctx, projectSlug)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(namespaces) == 0 {
+		return nil, errors.ErrNotFound.WithMsgf("Alert namespace not found for given project id")
+	} else if len(namespaces) > 1 {
+		names := make([]string, len(namespaces))
+		for i, ns := range namespaces {
+			names[i] = ns.Name
+		}
+		return nil, errors.ErrInternal.
+			WithMsgf("project '%s' is mapped to multiple alert namespaces: %s", projectSlug, strings.Join(names, ", ")).
+			WithCausef("more than 1 namespaces have project in label '%s'", projectSlugSirenLabelKey)
+	}
+	return namespaces[0], nil
+}
+
+// lookup returns the namespaces of the project from the index. Returns
+// false if the index needs to be rebuilt to answer the lookup.
+func (idx *namespaceIndex) lookup(projectSlug string) ([]*namespace, bool) {
+	idx.mu.RLock()
+	defer idx.mu.RUnlock()
+
+	if idx.byProject == nil || time.Since(idx.builtAt) >= idx.maxAge {
+		return nil, false
+	}
+
+	namespaces, found := idx.byProject[projectSlug]
+	if !found && time.Since(idx.builtAt) >= minMissRefreshInterval {
+		// namespace might have been added since the last build.
+		return nil, false
+	}
+	return namespaces, true
+}
+
+// refresh rebuilds the index and returns the namespaces of the project.
+// Concurrent refreshes share a single call to Siren.
+func (idx *namespaceIndex) refresh(ctx context.Context, projectSlug string) ([]*namespace, error) {
+	v, err, _ := idx.group.Do("refresh", func() (interface{}, error) {
+		resp, err := idx.siren.ListNamespaces(ctx, &sirenv1beta1.ListNamespacesRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		byProject := map[string][]*namespace{}
+		for _, ns := range resp.GetNamespaces() {
+			for _, project := range strings.Split(ns.Labels[projectSlugSirenLabelKey], ",") {
+				project = strings.TrimSpace(project)
+				if project != "" {
+					byProject[project] = append(byProject[project], mapProtoNamespaceToNamespace(ns))
+				}
+			}
+		}
+
+		idx.mu.Lock()
+		idx.byProject, idx.builtAt = byProject, time.Now()
+		idx.mu.Unlock()
+
+		return byProject, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return v.(map[string][]*namespace)[projectSlug], nil
+}
+
+func (idx *namespaceIndex) invalidate() {
+	idx.mu.Lock()
+	defer idx.mu.Unlock()
+	idx.byProject = nil
+}
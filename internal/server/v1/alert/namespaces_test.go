@@ -0,0 +1,100 @@
+package alert
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type fakeSirenClient struct {
+	sirenv1beta1.SirenServiceClient
+	namespaces []*sirenv1beta1.Namespace
+	listCalls  int32
+
+	rules []*sirenv1beta1.Rule
+	// failTemplate makes updates to rules of this template fail.
+	failTemplate string
+}
+
+func (f *fakeSirenClient) ListRules(_ context.Context, in *sirenv1beta1.ListRulesRequest, _ ...grpc.CallOption) (*sirenv1beta1.ListRulesResponse, error) {
+	var rules []*sirenv1beta1.Rule
+	for _, r := range f.rules {
+		if (in.GetNamespace() == "" || r.Namespace == in.GetNamespace()) && r.ProviderNamespace == in.GetProviderNamespace() {
+			rules = append(rules, r)
+		}
+	}
+	return &sirenv1beta1.ListRulesResponse{Rules: rules}, nil
+}
+
+func (f *fakeSirenClient) UpdateRule(_ context.Context, in *sirenv1beta1.UpdateRuleRequest, _ ...grpc.CallOption) (*sirenv1beta1.UpdateRuleResponse, error) {
+	if in.GetTemplate() == f.failTemplate {
+		return nil, status.Error(codes.InvalidArgument, "bad variables")
+	}
+
+	rule := &sirenv1beta1.Rule{
+		Id:                uint64(len(f.rules) + 1),
+		Enabled:           in.GetEnabled(),
+		GroupName:         in.GetGroupName(),
+		Namespace:         in.GetNamespace(),
+		Template:          in.GetTemplate(),
+		Variables:         in.GetVariables(),
+		ProviderNamespace: in.GetProviderNamespace(),
+	}
+	for i, r := range f.rules {
+		if r.Namespace == rule.Namespace && r.Template == rule.Template && r.ProviderNamespace == rule.ProviderNamespace {
+			rule.Id = r.Id
+			f.rules[i] = rule
+			return &sirenv1beta1.UpdateRuleResponse{Rule: rule}, nil
+		}
+	}
+	f.rules = append(f.rules, rule)
+	return &sirenv1beta1.UpdateRuleResponse{Rule: rule}, nil
+}
+
+func (f *fakeSirenClient) ListNamespaces(_ context.Context, _ *sirenv1beta1.ListNamespacesRequest, _ ...grpc.CallOption) (*sirenv1beta1.ListNamespacesResponse, error) {
+	atomic.AddInt32(&f.listCalls, 1)
+	return &sirenv1beta1.ListNamespacesResponse{Namespaces: f.namespaces}, nil
+}
+
+func TestService_getNamespaceForProject(t *testing.T) {
+	t.Parallel()
+
+	siren := &fakeSirenClient{
+		namespaces: []*sirenv1beta1.Namespace{
+			{Id: 1, Name: "ns-1", Labels: map[string]string{projectSlugSirenLabelKey: "foo,bar"}},
+			{Id: 2, Name: "ns-2", Labels: map[string]string{projectSlugSirenLabelKey: "bar"}},
+		},
+	}
+	svc := NewService(siren, NamespaceCacheConfig{RefreshInterval: time.Minute})
+
+	ns, err := svc.getNamespaceForProject(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), ns.ID)
+
+	_, err = svc.getNamespaceForProject(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&siren.listCalls))
+
+	_, err = svc.getNamespaceForProject(context.Background(), "bar")
+	assert.ErrorIs(t, err, errors.ErrInternal)
+	assert.Contains(t, err.Error(), "ns-1, ns-2")
+
+	_, err = svc.getNamespaceForProject(context.Background(), "unknown")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&siren.listCalls))
+
+	svc.InvalidateNamespaces()
+	_, err = svc.getNamespaceForProject(context.Background(), "foo")
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&siren.listCalls))
+}
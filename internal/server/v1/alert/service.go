@@ -2,7 +2,9 @@ package alert
 
 import (
 	"context"
+	"sort"
 	"strings"
+	"time"
 
 	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
 	"google.golang.org/grpc/codes"
@@ -12,9 +14,53 @@ import (
 )
 
 type Service struct {
-	Siren sirenv1beta1.SirenServiceClient
+	Siren      sirenv1beta1.SirenServiceClient
+	namespaces *namespaceIndex
 }
 
+// NewService returns an alert service backed by Siren.
+func NewService(siren sirenv1beta1.SirenServiceClient, cfg NamespaceCacheConfig) *Service {
+	return &Service{
+		Siren: siren,
+		namespaces: &namespaceIndex{
+			siren:  siren,
+			maxAge: cfg.RefreshInterval,
+		},
+	}
+}
+
+// RefreshNamespaces rebuilds the project to namespace index periodically
+// until the context is cancelled. Returns immediately if caching is not
+// enabled.
+func (s *Service) RefreshNamespaces(ctx context.Context) {
+	if s.namespaces.maxAge <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(s.namespaces.maxAge)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			// failures are retried on the next tick or the next lookup.
+			_, _ = s.namespaces.refresh(ctx, "")
+		}
+	}
+}
+
+// InvalidateNamespaces drops the project to namespace index so that the
+// next lookup fetches the namespaces from Siren.
+func (s *Service) InvalidateNamespaces() {
+	s.namespaces.invalidate()
+}
+
+// UpsertAlertPolicy updates the rules of the resource to match the given
+// policy. Only the rules that differ are updated and rules missing in the
+// policy are disabled. If any update fails, the previous policy is restored.
 func (s *Service) UpsertAlertPolicy(ctx context.Context, projectSlug string, update Policy) (*Policy, error) {
 	ns, err := s.getNamespaceForProject(ctx, projectSlug)
 	if err != nil {
@@ -26,23 +72,11 @@ func (s *Service) UpsertAlertPolicy(ctx context.Context, projectSlug string, upd
 		return nil, err
 	}
 
-	if alertPolicy != nil && len(alertPolicy.Rules) != 0 {
-		disableRuleRequests := mapAlertPolicyToUpdateRulesRequest(*alertPolicy, ns.ID)
-		for _, request := range disableRuleRequests {
-			request.Enabled = false
-			_, err := s.Siren.UpdateRule(ctx, request)
-			if err != nil {
-				return nil, err
-			}
-		}
-	}
-
-	updateRuleRequests := mapAlertPolicyToUpdateRulesRequest(update, ns.ID)
-	for _, request := range updateRuleRequests {
-		_, err := s.Siren.UpdateRule(ctx, request)
-		if err != nil {
-			return nil, err
-		}
+	changes, err := diffPolicy(alertPolicy, update)
+	if err != nil {
+		return nil, err
+	} else if err := s.applyRuleChanges(ctx, ns.ID, update.Resource, changes); err != nil {
+		return nil, err
 	}
 
 	alertPolicy, err = s.getAlertPolicyForResource(ctx, ns.ID, update.Resource)
@@ -67,22 +101,150 @@ func (s *Service) GetAlertPolicy(ctx context.Context, projectSlug string, resour
 	return alertPolicy, nil
 }
 
-func (s *Service) ListAlerts(ctx context.Context, projectSlug string, resource string) ([]Alert, error) {
+// GetAlertRule returns the rule of the resource created from the template.
+func (s *Service) GetAlertRule(ctx context.Context, projectSlug, resource, template string) (*Rule, error) {
+	policy, err := s.GetAlertPolicy(ctx, projectSlug, resource)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rule := range policy.Rules {
+		if rule.Template == template {
+			return &rule, nil
+		}
+	}
+	return nil, errors.ErrNotFound.WithMsgf(alertRuleNotFound, template)
+}
+
+// UpsertAlertRule creates or replaces the rule with the same template in
+// the alert policy of the resource. Other rules are left untouched.
+func (s *Service) UpsertAlertRule(ctx context.Context, projectSlug, resource string, rule Rule) (*Rule, error) {
+	policy, err := s.GetAlertPolicy(ctx, projectSlug, resource)
+	if err != nil {
+		if !errors.Is(err, errors.ErrNotFound) {
+			return nil, err
+		}
+		policy = &Policy{Resource: resource}
+	}
+
+	replaced := false
+	for i, r := range policy.Rules {
+		if r.Template == rule.Template {
+			policy.Rules[i], replaced = rule, true
+		}
+	}
+	if !replaced {
+		policy.Rules = append(policy.Rules, rule)
+	}
+
+	if _, err := s.UpsertAlertPolicy(ctx, projectSlug, *policy); err != nil {
+		return nil, err
+	}
+	return s.GetAlertRule(ctx, projectSlug, resource, rule.Template)
+}
+
+// SetAlertRuleEnabled enables or disables the rule of the resource created
+// from the template. Rule variables are left untouched.
+func (s *Service) SetAlertRuleEnabled(ctx context.Context, projectSlug, resource, template string, enabled bool) (*Rule, error) {
+	rule, err := s.GetAlertRule(ctx, projectSlug, resource, template)
+	if err != nil {
+		return nil, err
+	}
+
+	rule.Enabled = enabled
+	return s.UpsertAlertRule(ctx, projectSlug, resource, *rule)
+}
+
+// DisableAlertPolicy disables all the rules of the resource. Returns nil if
+// the resource has no alert policy.
+func (s *Service) DisableAlertPolicy(ctx context.Context, projectSlug string, resource string) error {
+	_, err := s.UpsertAlertPolicy(ctx, projectSlug, Policy{Resource: resource})
+	if err != nil && !errors.Is(err, errors.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+// ListAlertPolicies returns the alert policies of all the resources in the
+// alert namespace of the project.
+func (s *Service) ListAlertPolicies(ctx context.Context, projectSlug string) ([]Policy, error) {
 	ns, err := s.getNamespaceForProject(ctx, projectSlug)
 	if err != nil {
 		return nil, err
 	}
 
-	alertsResp, err := s.Siren.ListAlerts(ctx, &sirenv1beta1.ListAlertsRequest{
+	rpcResp, err := s.Siren.ListRules(ctx, &sirenv1beta1.ListRulesRequest{
+		ProviderNamespace: ns.ID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	policies := mapRulesToAlertPolicy(rpcResp.GetRules())
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Resource < policies[j].Resource
+	})
+	return policies, nil
+}
+
+// NamespaceProjects returns the slugs of all the projects sharing the alert
+// namespace with the given project.
+func (s *Service) NamespaceProjects(ctx context.Context, projectSlug string) ([]string, error) {
+	ns, err := s.getNamespaceForProject(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []string
+	for _, project := range strings.Split(ns.Labels[projectSlugSirenLabelKey], ",") {
+		if project = strings.TrimSpace(project); project != "" {
+			projects = append(projects, project)
+		}
+	}
+	return projects, nil
+}
+
+// ListAlerts returns the alerts triggered for the resource that match the
+// filter, latest first. Alerts of all the resources in the alert namespace
+// of the project are returned if resource is empty.
+func (s *Service) ListAlerts(ctx context.Context, projectSlug string, resource string, filter AlertFilter) ([]Alert, error) {
+	ns, err := s.getNamespaceForProject(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcReq := &sirenv1beta1.ListAlertsRequest{
 		ProviderName: alertProviderName,
 		ProviderId:   ns.Provider,
 		ResourceName: resource,
-	})
+	}
+	if !filter.From.IsZero() {
+		rpcReq.StartTime = uint64(filter.From.Unix())
+	}
+	if !filter.To.IsZero() {
+		rpcReq.EndTime = uint64(filter.To.Unix())
+	}
+
+	alertsResp, err := s.Siren.ListAlerts(ctx, rpcReq)
 	if err != nil {
 		return nil, err
 	}
 
-	return mapProtoAlertsToAlerts(alertsResp.GetAlerts()), nil
+	alerts := []Alert{}
+	for _, a := range mapProtoAlertsToAlerts(alertsResp.GetAlerts()) {
+		if filter.matches(a) {
+			alerts = append(alerts, a)
+		}
+	}
+	// latest first, with ID breaking the ties to keep the order (and hence
+	// the pages) stable.
+	sort.Slice(alerts, func(i, j int) bool {
+		if !alerts[i].TriggeredAt.Equal(alerts[j].TriggeredAt) {
+			return alerts[i].TriggeredAt.After(alerts[j].TriggeredAt)
+		}
+		return alerts[i].ID > alerts[j].ID
+	})
+	return alerts, nil
 }
 
 func (s *Service) ListAlertTemplates(ctx context.Context, tag string) ([]Template, error) {
@@ -137,20 +299,21 @@ func (s *Service) getAlertPolicyForResource(ctx context.Context, providerNamespa
 	return &alertPolicies[0], nil
 }
 
-func (s *Service) getNamespaceForProject(ctx context.Context, projectSlug string) (*namespace, error) {
-	listNamespacesResponse, err := s.Siren.ListNamespaces(ctx, &sirenv1beta1.ListNamespacesRequest{})
-	if err != nil {
-		return nil, err
-	}
-	for _, namespace := range listNamespacesResponse.GetNamespaces() {
-		projects := strings.Split(namespace.Labels[projectSlugSirenLabelKey], ",")
-		for _, project := range projects {
-			if project == projectSlug {
-				return mapProtoNamespaceToNamespace(namespace), nil
-			}
+// updateRule updates the rule in Siren. The namespace index is dropped if
+// Siren does not find the rule's namespace since the namespace might have
+// been removed or relabelled.
+func (s *Service) updateRule(ctx context.Context, req *sirenv1beta1.UpdateRuleRequest) error {
+	if _, err := s.Siren.UpdateRule(ctx, req); err != nil {
+		if status.Code(err) == codes.NotFound {
+			s.InvalidateNamespaces()
 		}
+		return err
 	}
-	return nil, errors.ErrNotFound.WithMsgf("Alert namespace not found for given project id")
+	return nil
+}
+
+func (s *Service) getNamespaceForProject(ctx context.Context, projectSlug string) (*namespace, error) {
+	return s.namespaces.get(ctx, projectSlug)
 }
 
 func (s *Service) GetProjectDataSource(ctx context.Context, projectSlug string) (string, error) {
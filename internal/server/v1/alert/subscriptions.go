@@ -0,0 +1,178 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const subscriptionNotFound = "no Subscription found with id '%s'"
+
+// ListSubscriptions returns the subscriptions in the alert namespace of the
+// project that match at least the given labels.
+func (s *Service) ListSubscriptions(ctx context.Context, projectSlug string, match map[string]string) ([]Subscription, error) {
+	ns, err := s.getNamespaceForProject(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := s.Siren.ListSubscriptions(ctx, &sirenv1beta1.ListSubscriptionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := []Subscription{}
+	for _, sub := range rpcResp.GetSubscriptions() {
+		if sub.GetNamespace() == ns.ID && matchesLabels(sub.GetMatch(), match) {
+			subscriptions = append(subscriptions, mapProtoSubscriptionToSubscription(sub))
+		}
+	}
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].URN < subscriptions[j].URN
+	})
+	return subscriptions, nil
+}
+
+// CreateSubscription creates the subscription in the alert namespace of the
+// project.
+func (s *Service) CreateSubscription(ctx context.Context, projectSlug string, sub Subscription) (*Subscription, error) {
+	ns, err := s.getNamespaceForProject(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	rpcReq, err := mapSubscriptionToCreateSubscriptionRequest(sub, ns.ID)
+	if err != nil {
+		return nil, err
+	} else if err := s.validateReceivers(ctx, projectSlug, sub.Receivers); err != nil {
+		return nil, err
+	}
+
+	rpcResp, err := s.Siren.CreateSubscription(ctx, rpcReq)
+	if err != nil {
+		st := status.Convert(err)
+		switch st.Code() {
+		case codes.InvalidArgument:
+			return nil, errors.ErrInvalid.WithMsgf("subscription is not valid").WithCausef(st.Message())
+		case codes.AlreadyExists:
+			return nil, errors.ErrConflict.WithMsgf("subscription with urn '%s' already exists", sub.URN).WithCausef(st.Message())
+		}
+		return nil, err
+	}
+
+	return s.getSubscription(ctx, ns.ID, strconv.FormatUint(rpcResp.GetId(), defaultDecimalBase))
+}
+
+// DeleteSubscription deletes the subscription from the alert namespace of
+// the project. Subscriptions that do not match the given labels are treated
+// as not found.
+func (s *Service) DeleteSubscription(ctx context.Context, projectSlug, id string, match map[string]string) error {
+	ns, err := s.getNamespaceForProject(ctx, projectSlug)
+	if err != nil {
+		return err
+	}
+
+	sub, err := s.getSubscription(ctx, ns.ID, id)
+	if err != nil {
+		return err
+	} else if !matchesLabels(sub.Match, match) {
+		return errors.ErrNotFound.WithMsgf(subscriptionNotFound, id)
+	}
+
+	subID, _ := strconv.ParseUint(sub.ID, defaultDecimalBase, 64)
+	_, err = s.Siren.DeleteSubscription(ctx, &sirenv1beta1.DeleteSubscriptionRequest{Id: subID})
+	return err
+}
+
+// ListReceivers returns the receivers the alerts of the project can be
+// routed to. Like namespaces, receivers belong to the projects listed in
+// their 'projects' label.
+func (s *Service) ListReceivers(ctx context.Context, projectSlug string) ([]Receiver, error) {
+	rpcResp, err := s.Siren.ListReceivers(ctx, &sirenv1beta1.ListReceiversRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	receivers := []Receiver{}
+	for _, r := range rpcResp.GetReceivers() {
+		if hasProjectLabel(r.GetLabels(), projectSlug) {
+			receivers = append(receivers, mapProtoReceiverToReceiver(r))
+		}
+	}
+	return receivers, nil
+}
+
+func (s *Service) getSubscription(ctx context.Context, providerNamespace uint64, id string) (*Subscription, error) {
+	rpcResp, err := s.Siren.ListSubscriptions(ctx, &sirenv1beta1.ListSubscriptionsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sub := range rpcResp.GetSubscriptions() {
+		if sub.GetNamespace() == providerNamespace && strconv.FormatUint(sub.GetId(), defaultDecimalBase) == id {
+			res := mapProtoSubscriptionToSubscription(sub)
+			return &res, nil
+		}
+	}
+	return nil, errors.ErrNotFound.WithMsgf(subscriptionNotFound, id)
+}
+
+// validateReceivers returns ErrInvalid if any of the receivers does not
+// belong to the project.
+func (s *Service) validateReceivers(ctx context.Context, projectSlug string, receivers []SubscriptionReceiver) error {
+	available, err := s.ListReceivers(ctx, projectSlug)
+	if err != nil {
+		return err
+	}
+
+	ids := map[string]bool{}
+	for _, r := range available {
+		ids[r.ID] = true
+	}
+
+	var fieldErrs []errors.FieldError
+	for i, r := range receivers {
+		if !ids[r.ID] {
+			fieldErrs = append(fieldErrs, errors.FieldError{
+				Field:  fmt.Sprintf("receivers[%d].id", i),
+				Reason: fmt.Sprintf("receiver '%s' does not exist in project '%s'", r.ID, projectSlug),
+			})
+		}
+	}
+
+	if len(fieldErrs) > 0 {
+		return errors.ErrInvalid.
+			WithMsgf("subscription is not valid").
+			WithDetails(fieldErrs)
+	}
+	return nil
+}
+
+// hasProjectLabel returns true if the project is listed in the project
+// label of a Siren entity.
+func hasProjectLabel(labels map[string]string, projectSlug string) bool {
+	for _, project := range strings.Split(labels[projectSlugSirenLabelKey], ",") {
+		if strings.TrimSpace(project) == projectSlug {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesLabels returns true if labels has all the entries in subset.
+func matchesLabels(labels, subset map[string]string) bool {
+	for k, v := range subset {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
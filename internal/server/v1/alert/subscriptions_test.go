@@ -0,0 +1,135 @@
+package alert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+	"google.golang.org/grpc"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type fakeSubscriptionClient struct {
+	fakeSirenClient
+	subscriptions []*sirenv1beta1.Subscription
+	receivers     []*sirenv1beta1.Receiver
+}
+
+func (f *fakeSubscriptionClient) ListSubscriptions(_ context.Context, _ *sirenv1beta1.ListSubscriptionsRequest, _ ...grpc.CallOption) (*sirenv1beta1.ListSubscriptionsResponse, error) {
+	return &sirenv1beta1.ListSubscriptionsResponse{Subscriptions: f.subscriptions}, nil
+}
+
+func (f *fakeSubscriptionClient) CreateSubscription(_ context.Context, in *sirenv1beta1.CreateSubscriptionRequest, _ ...grpc.CallOption) (*sirenv1beta1.CreateSubscriptionResponse, error) {
+	sub := &sirenv1beta1.Subscription{
+		Id:        uint64(len(f.subscriptions) + 1),
+		Urn:       in.GetUrn(),
+		Namespace: in.GetNamespace(),
+		Receivers: in.GetReceivers(),
+		Match:     in.GetMatch(),
+	}
+	f.subscriptions = append(f.subscriptions, sub)
+	return &sirenv1beta1.CreateSubscriptionResponse{Id: sub.Id}, nil
+}
+
+func (f *fakeSubscriptionClient) DeleteSubscription(_ context.Context, in *sirenv1beta1.DeleteSubscriptionRequest, _ ...grpc.CallOption) (*sirenv1beta1.DeleteSubscriptionResponse, error) {
+	for i, sub := range f.subscriptions {
+		if sub.Id == in.GetId() {
+			f.subscriptions = append(f.subscriptions[:i], f.subscriptions[i+1:]...)
+			break
+		}
+	}
+	return &sirenv1beta1.DeleteSubscriptionResponse{}, nil
+}
+
+func TestService_Subscriptions(t *testing.T) {
+	t.Parallel()
+
+	siren := &fakeSubscriptionClient{
+		fakeSirenClient: fakeSirenClient{
+			namespaces: []*sirenv1beta1.Namespace{
+				{Id: 1, Name: "ns-1", Labels: map[string]string{projectSlugSirenLabelKey: "foo"}},
+			},
+		},
+		subscriptions: []*sirenv1beta1.Subscription{
+			// belongs to another namespace.
+			{Id: 100, Urn: "other", Namespace: 2, Match: map[string]string{"name": "fh-1"}},
+		},
+		receivers: []*sirenv1beta1.Receiver{
+			{Id: 7, Name: "foo-slack", Labels: map[string]string{projectSlugSirenLabelKey: "foo"}},
+			{Id: 8, Name: "bar-slack", Labels: map[string]string{projectSlugSirenLabelKey: "bar"}},
+		},
+	}
+	svc := NewService(siren, NamespaceCacheConfig{})
+	ctx := context.Background()
+
+	created, err := svc.CreateSubscription(ctx, "foo", Subscription{
+		URN:       "fh-1-slack",
+		Receivers: []SubscriptionReceiver{{ID: "7", Configuration: map[string]string{"channel_name": "alerts"}}},
+		Match:     map[string]string{"name": "fh-1", "severity": "CRITICAL"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "fh-1-slack", created.URN)
+	assert.Equal(t, []SubscriptionReceiver{{ID: "7", Configuration: map[string]string{"channel_name": "alerts"}}}, created.Receivers)
+
+	_, err = svc.CreateSubscription(ctx, "foo", Subscription{Receivers: []SubscriptionReceiver{{ID: "slack"}}})
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+
+	// receivers of other projects cannot be used.
+	_, err = svc.CreateSubscription(ctx, "foo", Subscription{
+		URN:       "fh-1-other",
+		Receivers: []SubscriptionReceiver{{ID: "7"}, {ID: "8"}, {ID: "9"}},
+	})
+	require.ErrorIs(t, err, errors.ErrInvalid)
+	fieldErrs := err.(errors.Error).Details.([]errors.FieldError)
+	require.Len(t, fieldErrs, 2)
+	assert.Equal(t, "receivers[1].id", fieldErrs[0].Field)
+	assert.Equal(t, "receivers[2].id", fieldErrs[1].Field)
+	assert.Len(t, siren.subscriptions, 2)
+
+	subs, err := svc.ListSubscriptions(ctx, "foo", map[string]string{"name": "fh-1"})
+	require.NoError(t, err)
+	require.Len(t, subs, 1)
+	assert.Equal(t, created.ID, subs[0].ID)
+
+	subs, err = svc.ListSubscriptions(ctx, "foo", map[string]string{"name": "fh-2"})
+	require.NoError(t, err)
+	assert.Empty(t, subs)
+
+	err = svc.DeleteSubscription(ctx, "foo", created.ID, map[string]string{"name": "fh-2"})
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	err = svc.DeleteSubscription(ctx, "foo", "100", map[string]string{"name": "fh-1"})
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	require.NoError(t, svc.DeleteSubscription(ctx, "foo", created.ID, map[string]string{"name": "fh-1"}))
+	assert.Len(t, siren.subscriptions, 1)
+}
+
+func (f *fakeSubscriptionClient) ListReceivers(_ context.Context, _ *sirenv1beta1.ListReceiversRequest, _ ...grpc.CallOption) (*sirenv1beta1.ListReceiversResponse, error) {
+	return &sirenv1beta1.ListReceiversResponse{Receivers: f.receivers}, nil
+}
+
+func TestService_ListReceivers(t *testing.T) {
+	t.Parallel()
+
+	siren := &fakeSubscriptionClient{
+		receivers: []*sirenv1beta1.Receiver{
+			{Id: 1, Name: "foo-slack", Labels: map[string]string{projectSlugSirenLabelKey: "foo, bar"}},
+			{Id: 2, Name: "baz-pager", Labels: map[string]string{projectSlugSirenLabelKey: "baz"}},
+			{Id: 3, Name: "unlabelled"},
+		},
+	}
+	svc := NewService(siren, NamespaceCacheConfig{})
+
+	receivers, err := svc.ListReceivers(context.Background(), "bar")
+	require.NoError(t, err)
+	require.Len(t, receivers, 1)
+	assert.Equal(t, "1", receivers[0].ID)
+
+	receivers, err = svc.ListReceivers(context.Background(), "qux")
+	require.NoError(t, err)
+	assert.Empty(t, receivers)
+}
@@ -0,0 +1,177 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	ruleActionCreate  = "create"
+	ruleActionUpdate  = "update"
+	ruleActionDisable = "disable"
+)
+
+// RuleChange describes a change to a single rule of an alert policy.
+type RuleChange struct {
+	Template string `json:"template"`
+	Action   string `json:"action"`
+}
+
+// UpsertFailure is attached as details to the error returned when an alert
+// policy upsert fails midway.
+type UpsertFailure struct {
+	Applied        []RuleChange `json:"applied"`
+	Failed         RuleChange   `json:"failed"`
+	RolledBack     bool         `json:"rolled_back"`
+	RollbackErrors []string     `json:"rollback_errors,omitempty"`
+}
+
+type ruleChange struct {
+	action string
+	prev   *Rule // nil if the rule is being created.
+	next   Rule
+}
+
+func (rc ruleChange) summary() RuleChange {
+	return RuleChange{Template: rc.next.Template, Action: rc.action}
+}
+
+// diffPolicy returns the rule changes required to move from the current
+// policy to the updated one. Rules are identified by their template and
+// rules missing in the update are disabled.
+func diffPolicy(cur *Policy, update Policy) ([]ruleChange, error) {
+	curRules := map[string]Rule{}
+	if cur != nil {
+		for _, r := range cur.Rules {
+			curRules[r.Template] = r
+		}
+	}
+
+	seen := map[string]bool{}
+	var changes []ruleChange
+	for _, next := range update.Rules {
+		if seen[next.Template] {
+			return nil, errors.ErrInvalid.
+				WithMsgf("alert policy has more than one rule for template '%s'", next.Template)
+		}
+		seen[next.Template] = true
+
+		prev, exists := curRules[next.Template]
+		if !exists {
+			changes = append(changes, ruleChange{action: ruleActionCreate, next: next})
+		} else if !sameRule(prev, next) {
+			prev := prev
+			changes = append(changes, ruleChange{action: ruleActionUpdate, prev: &prev, next: next})
+		}
+	}
+
+	if cur != nil {
+		for _, prev := range cur.Rules {
+			if seen[prev.Template] || !prev.Enabled {
+				continue
+			}
+
+			prev := prev
+			next := prev
+			next.Enabled = false
+			changes = append(changes, ruleChange{action: ruleActionDisable, prev: &prev, next: next})
+		}
+	}
+
+	return changes, nil
+}
+
+func sameRule(r1, r2 Rule) bool {
+	if r1.Enabled != r2.Enabled || len(r1.Variables) != len(r2.Variables) {
+		return false
+	}
+
+	values := map[string]string{}
+	for _, v := range r1.Variables {
+		values[v.Name] = v.Value
+	}
+	for _, v := range r2.Variables {
+		if val, found := values[v.Name]; !found || val != v.Value {
+			return false
+		}
+	}
+	return true
+}
+
+// applyRuleChanges applies the changes in order. If any of the changes
+// fail, the changes applied so far are reverted and an error describing
+// the partial progress is returned.
+func (s *Service) applyRuleChanges(ctx context.Context, providerNamespace uint64, resource string, changes []ruleChange) error {
+	for i, change := range changes {
+		err := s.updateRule(ctx, mapRuleToUpdateRuleRequest(resource, change.next, providerNamespace))
+		if err != nil {
+			rollbackErrs := s.revertRuleChanges(ctx, providerNamespace, resource, changes[:i])
+			return upsertError(err, changes[:i], change, rollbackErrs)
+		}
+	}
+	return nil
+}
+
+// revertRuleChanges reverts the applied changes in the reverse order.
+// Rules created by the changes are disabled since Siren does not support
+// deleting rules.
+func (s *Service) revertRuleChanges(ctx context.Context, providerNamespace uint64, resource string, applied []ruleChange) []string {
+	var errs []string
+	for i := len(applied) - 1; i >= 0; i-- {
+		change := applied[i]
+
+		var rule Rule
+		if change.prev != nil {
+			rule = *change.prev
+		} else {
+			rule = change.next
+			rule.Enabled = false
+		}
+
+		if err := s.updateRule(ctx, mapRuleToUpdateRuleRequest(resource, rule, providerNamespace)); err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", rule.Template, err))
+		}
+	}
+	return errs
+}
+
+func upsertError(cause error, applied []ruleChange, failed ruleChange, rollbackErrs []string) error {
+	failure := UpsertFailure{
+		Applied:        []RuleChange{},
+		Failed:         failed.summary(),
+		RolledBack:     len(rollbackErrs) == 0,
+		RollbackErrors: rollbackErrs,
+	}
+
+	appliedNames := make([]string, len(applied))
+	for i, change := range applied {
+		failure.Applied = append(failure.Applied, change.summary())
+		appliedNames[i] = change.next.Template
+	}
+
+	st := status.Convert(cause)
+	base := errors.ErrInternal
+	if st.Code() == codes.InvalidArgument {
+		base = errors.ErrInvalid
+	}
+
+	msg := fmt.Sprintf("failed to %s rule '%s'", failed.action, failed.next.Template)
+	if len(applied) > 0 {
+		if failure.RolledBack {
+			msg += fmt.Sprintf("; changes to rules [%s] were rolled back", strings.Join(appliedNames, ", "))
+		} else {
+			msg += fmt.Sprintf("; rolling back changes to rules [%s] failed", strings.Join(appliedNames, ", "))
+		}
+	}
+
+	return base.
+		WithMsgf("%s", msg).
+		WithCausef(st.Message()).
+		WithDetails(failure)
+}
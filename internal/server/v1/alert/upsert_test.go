@@ -0,0 +1,125 @@
+package alert
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func Test_diffPolicy(t *testing.T) {
+	t.Parallel()
+
+	cur := &Policy{
+		Resource: "fh-1",
+		Rules: []Rule{
+			{Template: "lag", Enabled: true, Variables: []Variable{{Name: "warn", Value: "10"}}},
+			{Template: "down", Enabled: true},
+			{Template: "old", Enabled: false},
+		},
+	}
+
+	changes, err := diffPolicy(cur, Policy{
+		Resource: "fh-1",
+		Rules: []Rule{
+			{Template: "lag", Enabled: true, Variables: []Variable{{Name: "warn", Value: "20"}}},
+			{Template: "new", Enabled: true},
+		},
+	})
+	require.NoError(t, err)
+
+	var got []RuleChange
+	for _, c := range changes {
+		got = append(got, c.summary())
+	}
+	assert.Equal(t, []RuleChange{
+		{Template: "lag", Action: ruleActionUpdate},
+		{Template: "new", Action: ruleActionCreate},
+		{Template: "down", Action: ruleActionDisable},
+	}, got)
+
+	changes, err = diffPolicy(cur, *cur)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+
+	_, err = diffPolicy(nil, Policy{Rules: []Rule{{Template: "lag"}, {Template: "lag"}}})
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+}
+
+func TestService_UpsertAlertPolicy_Rollback(t *testing.T) {
+	t.Parallel()
+
+	siren := &fakeSirenClient{
+		namespaces: []*sirenv1beta1.Namespace{
+			{Id: 1, Name: "ns-1", Labels: map[string]string{projectSlugSirenLabelKey: "foo"}},
+		},
+		rules: []*sirenv1beta1.Rule{
+			{Id: 1, Namespace: "fh-1", Template: "lag", Enabled: true, ProviderNamespace: 1,
+				Variables: []*sirenv1beta1.Variables{{Name: "warn", Value: "10"}}},
+			{Id: 2, Namespace: "fh-1", Template: "down", Enabled: true, ProviderNamespace: 1},
+		},
+		failTemplate: "broken",
+	}
+	svc := NewService(siren, NamespaceCacheConfig{})
+
+	_, err := svc.UpsertAlertPolicy(context.Background(), "foo", Policy{
+		Resource: "fh-1",
+		Rules: []Rule{
+			{Template: "lag", Enabled: true, Variables: []Variable{{Name: "warn", Value: "20"}}},
+			{Template: "broken", Enabled: true},
+		},
+	})
+	require.ErrorIs(t, err, errors.ErrInvalid)
+
+	var e errors.Error
+	require.True(t, errors.As(err, &e))
+	failure, ok := e.Details.(UpsertFailure)
+	require.True(t, ok)
+	assert.True(t, failure.RolledBack)
+	assert.Equal(t, []RuleChange{{Template: "lag", Action: ruleActionUpdate}}, failure.Applied)
+	assert.Equal(t, RuleChange{Template: "broken", Action: ruleActionCreate}, failure.Failed)
+
+	// previous policy must be intact.
+	policy, err := svc.GetAlertPolicy(context.Background(), "foo", "fh-1")
+	require.NoError(t, err)
+	for _, r := range policy.Rules {
+		assert.True(t, r.Enabled)
+		if r.Template == "lag" {
+			assert.Equal(t, "10", r.Variables[0].Value)
+		}
+	}
+}
+
+func TestService_UpsertAlertRule(t *testing.T) {
+	t.Parallel()
+
+	siren := &fakeSirenClient{
+		namespaces: []*sirenv1beta1.Namespace{
+			{Id: 1, Name: "ns-1", Labels: map[string]string{projectSlugSirenLabelKey: "foo"}},
+		},
+		rules: []*sirenv1beta1.Rule{
+			{Id: 1, Namespace: "fh-1", Template: "lag", Enabled: true, ProviderNamespace: 1},
+		},
+	}
+	svc := NewService(siren, NamespaceCacheConfig{})
+	ctx := context.Background()
+
+	rule, err := svc.UpsertAlertRule(ctx, "foo", "fh-1", Rule{Template: "down", Enabled: true})
+	require.NoError(t, err)
+	assert.Equal(t, "down", rule.Template)
+
+	rule, err = svc.SetAlertRuleEnabled(ctx, "foo", "fh-1", "lag", false)
+	require.NoError(t, err)
+	assert.False(t, rule.Enabled)
+
+	policy, err := svc.GetAlertPolicy(ctx, "foo", "fh-1")
+	require.NoError(t, err)
+	assert.Len(t, policy.Rules, 2)
+
+	_, err = svc.GetAlertRule(ctx, "foo", "fh-1", "unknown")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+}
@@ -0,0 +1,161 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// ValidateRules validates the rule variables against the variables declared
+// by the rule templates. Missing variables that have defaults in template
+// are filled in. Supplied variables are set by Dex, so they are passed
+// through unchanged if the template does not declare them, and are not
+// required to have a value. Returns ErrInvalid with field errors if any of
+// the rules are not valid.
+func (s *Service) ValidateRules(ctx context.Context, rules []Rule, supplied []string) ([]Rule, error) {
+	templates := map[string]*Template{}
+
+	var fieldErrs []errors.FieldError
+	result := make([]Rule, len(rules))
+	for i, rule := range rules {
+		field := fmt.Sprintf("rules[%d]", i)
+
+		tmpl, found := templates[rule.Template]
+		if !found {
+			var err error
+			tmpl, err = s.GetAlertTemplate(ctx, rule.Template)
+			if err != nil && !errors.Is(err, errors.ErrNotFound) {
+				return nil, err
+			}
+			templates[rule.Template] = tmpl
+		}
+
+		if tmpl == nil {
+			fieldErrs = append(fieldErrs, errors.FieldError{
+				Field:  field + ".template",
+				Reason: fmt.Sprintf("alert template '%s' does not exist", rule.Template),
+			})
+			result[i] = rule
+			continue
+		}
+
+		validated, errs := validateRuleVariables(field, rule, *tmpl, supplied)
+		fieldErrs = append(fieldErrs, errs...)
+		result[i] = validated
+	}
+
+	if len(fieldErrs) > 0 {
+		return nil, errors.ErrInvalid.
+			WithMsgf("alert rules are not valid").
+			WithDetails(fieldErrs)
+	}
+	return result, nil
+}
+
+func validateRuleVariables(field string, rule Rule, tmpl Template, supplied []string) (Rule, []errors.FieldError) {
+	var fieldErrs []errors.FieldError
+
+	declared := map[string]Variable{}
+	for _, v := range tmpl.Variables {
+		declared[v.Name] = v
+	}
+
+	given := map[string]bool{}
+	var vars []Variable
+	for _, v := range rule.Variables {
+		varField := fmt.Sprintf("%s.variables.%s", field, v.Name)
+
+		isSupplied := findInArray(supplied, v.Name)
+
+		decl, found := declared[v.Name]
+		if !found && isSupplied {
+			vars = append(vars, v)
+			continue
+		} else if !found {
+			fieldErrs = append(fieldErrs, errors.FieldError{
+				Field:  varField,
+				Reason: fmt.Sprintf("variable is not declared by template '%s'", tmpl.Name),
+			})
+			continue
+		} else if given[v.Name] {
+			fieldErrs = append(fieldErrs, errors.FieldError{Field: varField, Reason: "variable is set more than once"})
+			continue
+		}
+		given[v.Name] = true
+
+		if v.Value == "" && decl.Default != "" {
+			v.Value = decl.Default
+		}
+
+		switch {
+		case v.Value == "" && isSupplied:
+			// supplied value is not set by the user (e.g., team of a
+			// firehose without a group), so there is nothing to check.
+
+		case v.Value == "":
+			fieldErrs = append(fieldErrs, errors.FieldError{Field: varField, Reason: "value is required"})
+
+		default:
+			if reason := checkVariableType(decl.Type, v.Value); reason != "" {
+				fieldErrs = append(fieldErrs, errors.FieldError{Field: varField, Reason: reason})
+			}
+		}
+
+		v.Type = decl.Type
+		if v.Description == "" {
+			v.Description = decl.Description
+		}
+		vars = append(vars, v)
+	}
+
+	for _, decl := range tmpl.Variables {
+		if given[decl.Name] {
+			continue
+		}
+
+		if decl.Default == "" {
+			fieldErrs = append(fieldErrs, errors.FieldError{
+				Field:  fmt.Sprintf("%s.variables.%s", field, decl.Name),
+				Reason: "value is required",
+			})
+			continue
+		}
+
+		vars = append(vars, Variable{
+			Name:        decl.Name,
+			Value:       decl.Default,
+			Type:        decl.Type,
+			Description: decl.Description,
+		})
+	}
+
+	rule.Variables = vars
+	return rule, fieldErrs
+}
+
+// checkVariableType returns the reason if the value is not valid for the
+// declared type. Unknown types accept any value.
+func checkVariableType(typ, value string) string {
+	var err error
+	switch strings.ToLower(typ) {
+	case "int", "integer", "long":
+		_, err = strconv.ParseInt(value, 10, 64)
+
+	case "float", "double", "number":
+		_, err = strconv.ParseFloat(value, 64)
+
+	case "bool", "boolean":
+		_, err = strconv.ParseBool(value)
+
+	default:
+		return ""
+	}
+
+	if err != nil {
+		return fmt.Sprintf("value must be of type '%s'", typ)
+	}
+	return ""
+}
@@ -0,0 +1,82 @@
+package alert
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_validateRuleVariables(t *testing.T) {
+	t.Parallel()
+
+	tmpl := Template{
+		Name: "lag",
+		Variables: []Variable{
+			{Name: "warn", Type: "int", Description: "warning threshold"},
+			{Name: "for", Type: "string", Default: "5m"},
+			{Name: "name", Type: "string"},
+		},
+	}
+
+	t.Run("FillsDefaults", func(t *testing.T) {
+		t.Parallel()
+
+		rule, errs := validateRuleVariables("rules[0]", Rule{
+			Template: "lag",
+			Variables: []Variable{
+				{Name: "warn", Value: "10"},
+				{Name: "name", Value: "fh-1"},
+				{Name: "entity", Value: "odpf"},
+			},
+		}, tmpl, []string{"name", "entity"})
+		assert.Empty(t, errs)
+		assert.Equal(t, []Variable{
+			{Name: "warn", Value: "10", Type: "int", Description: "warning threshold"},
+			{Name: "name", Value: "fh-1", Type: "string"},
+			{Name: "entity", Value: "odpf"},
+			{Name: "for", Value: "5m", Type: "string"},
+		}, rule.Variables)
+	})
+
+	t.Run("EmptySuppliedVariable", func(t *testing.T) {
+		t.Parallel()
+
+		rule, errs := validateRuleVariables("rules[0]", Rule{
+			Template: "lag",
+			Variables: []Variable{
+				{Name: "warn", Value: "10"},
+				{Name: "name", Value: ""},
+				{Name: "team", Value: ""},
+			},
+		}, tmpl, []string{"name", "team"})
+		assert.Empty(t, errs)
+		assert.Equal(t, []Variable{
+			{Name: "warn", Value: "10", Type: "int", Description: "warning threshold"},
+			{Name: "name", Value: "", Type: "string"},
+			{Name: "team", Value: ""},
+			{Name: "for", Value: "5m", Type: "string"},
+		}, rule.Variables)
+	})
+
+	t.Run("InvalidVariables", func(t *testing.T) {
+		t.Parallel()
+
+		_, errs := validateRuleVariables("rules[0]", Rule{
+			Template: "lag",
+			Variables: []Variable{
+				{Name: "warn", Value: "ten"},
+				{Name: "unknown", Value: "1"},
+			},
+		}, tmpl, nil)
+
+		fields := map[string]string{}
+		for _, fe := range errs {
+			fields[fe.Field] = fe.Reason
+		}
+		assert.Equal(t, map[string]string{
+			"rules[0].variables.warn":    "value must be of type 'int'",
+			"rules[0].variables.unknown": "variable is not declared by template 'lag'",
+			"rules[0].variables.name":    "value is required",
+		}, fields)
+	})
+}
@@ -0,0 +1,137 @@
+package firehose
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+
+	"github.com/odpf/dex/internal/server/utils"
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// firehoseAlert is an alert along with the URN of the firehose it was
+// triggered for.
+type firehoseAlert struct {
+	alertsv1.Alert
+	URN string `json:"urn"`
+}
+
+type alertQuery struct {
+	Filter alertsv1.AlertFilter
+	Page   pageParams
+}
+
+func parseAlertQuery(r *http.Request) (*alertQuery, error) {
+	q := r.URL.Query()
+
+	var query alertQuery
+	for _, param := range []struct {
+		name string
+		into *time.Time
+	}{
+		{name: "from", into: &query.Filter.From},
+		{name: "to", into: &query.Filter.To},
+	} {
+		s := strings.TrimSpace(q.Get(param.name))
+		if s == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, errors.ErrInvalid.
+				WithMsgf("%s must be a RFC3339 timestamp", param.name).
+				WithCausef("invalid %s '%s'", param.name, s)
+		}
+		*param.into = t
+	}
+
+	if !query.Filter.From.IsZero() && !query.Filter.To.IsZero() && query.Filter.To.Before(query.Filter.From) {
+		return nil, errors.ErrInvalid.WithMsgf("to must not be before from")
+	}
+
+	for _, severity := range strings.Split(q.Get("severity"), ",") {
+		if severity = strings.TrimSpace(severity); severity != "" {
+			query.Filter.Severities = append(query.Filter.Severities, severity)
+		}
+	}
+
+	var err error
+	query.Page, err = parsePageParams(r)
+	if err != nil {
+		return nil, err
+	}
+	return &query, nil
+}
+
+func handleListProjectAlerts(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		query, err := parseAlertQuery(r)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		resp, err := client.ListResources(ctx, &entropyv1beta1.ListResourcesRequest{
+			Kind:    kindFirehose,
+			Project: prj.GetSlug(),
+		})
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		// alerts are triggered for release names, which need to be mapped
+		// back to the firehose URNs.
+		urns := releaseNameURNs(resp.GetResources())
+
+		// namespace might be shared with other projects, so the alerts of
+		// resources that are not firehoses of this project are skipped.
+		alerts, err := svc.ListAlerts(ctx, prj.GetSlug(), "", query.Filter)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		items := []firehoseAlert{}
+		for _, alert := range alerts {
+			if urn, found := urns[alert.Resource]; found {
+				items = append(items, firehoseAlert{Alert: alert, URN: urn})
+			}
+		}
+
+		items, nextPageToken := paginateAlerts(items, query.Page)
+		utils.WriteJSON(w, http.StatusOK, listResponse[firehoseAlert]{Items: items, NextPageToken: nextPageToken})
+	}
+}
+
+// paginateAlerts returns the page of alerts, which are listed latest first.
+func paginateAlerts(items []firehoseAlert, page pageParams) ([]firehoseAlert, string) {
+	return paginate(items, page, true, func(alert firehoseAlert) pageCursor {
+		return pageCursor{Key: formatCursorTime(alert.TriggeredAt), Name: alert.ID}
+	})
+}
+
+// releaseNameURNs returns the URNs of the firehose resources by their
+// release names. Firehoses that were never deployed are skipped.
+func releaseNameURNs(resources []*entropyv1beta1.Resource) map[string]string {
+	urns := map[string]string{}
+	for _, res := range resources {
+		if name, ok := getResourceReleaseName(res); ok {
+			urns[name] = res.GetUrn()
+		}
+	}
+	return urns
+}
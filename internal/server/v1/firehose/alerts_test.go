@@ -0,0 +1,47 @@
+package firehose
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func Test_parseAlertQuery(t *testing.T) {
+	t.Parallel()
+
+	aq, err := parseAlertQuery(httptest.NewRequest("GET", "/alerts?from=2022-01-01T00:00:00Z&severity=CRITICAL,%20WARNING&page_size=10", nil))
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), aq.Filter.From.UTC())
+	assert.True(t, aq.Filter.To.IsZero())
+	assert.Equal(t, []string{"CRITICAL", "WARNING"}, aq.Filter.Severities)
+	assert.Equal(t, 10, aq.Page.Size)
+
+	for _, query := range []string{"from=yesterday", "from=2022-01-02T00:00:00Z&to=2022-01-01T00:00:00Z", "page_size=0", "page_token=!!"} {
+		_, err := parseAlertQuery(httptest.NewRequest("GET", "/alerts?"+query, nil))
+		assert.ErrorIs(t, err, errors.ErrInvalid, query)
+	}
+}
+
+func Test_releaseNameURNs(t *testing.T) {
+	t.Parallel()
+
+	output, err := structpb.NewValue(map[string]interface{}{firehoseOutputReleaseNameKey: "f1-firehose"})
+	require.NoError(t, err)
+
+	resources := []*entropyv1beta1.Resource{
+		{Urn: "orn:foo:firehose:a:f1", State: &entropyv1beta1.ResourceState{Output: output}},
+		// never deployed.
+		{Urn: "orn:foo:firehose:a:f2"},
+		// configs are not valid.
+		{Urn: "orn:foo:firehose:a:f3", Spec: &entropyv1beta1.ResourceSpec{Configs: structpb.NewStringValue("bad")}},
+	}
+
+	assert.Equal(t, map[string]string{"f1-firehose": "orn:foo:firehose:a:f1"}, releaseNameURNs(resources))
+}
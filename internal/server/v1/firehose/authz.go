@@ -0,0 +1,137 @@
+package firehose
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// AuthzConfig configures the permission checks done against Shield before
+// the firehose APIs are served.
+type AuthzConfig struct {
+	Enabled bool `mapstructure:"enabled" default:"false"`
+
+	// IdentityHeader is the metadata key used to pass the user's email to
+	// Shield. Must match the identity proxy header configured in Shield.
+	IdentityHeader string `mapstructure:"identity_header" default:"X-Shield-Email"`
+
+	// ProjectNamespace is the Shield namespace of projects.
+	ProjectNamespace string `mapstructure:"project_namespace" default:"shield/project"`
+
+	// GroupNamespace is the Shield namespace of groups. APIs of a firehose
+	// that belongs to a group also require the permission on the group.
+	GroupNamespace string `mapstructure:"group_namespace" default:"shield/group"`
+
+	// Actions are the Shield permission names required for each kind
+	// of firehose API.
+	Actions AuthzActions `mapstructure:"actions"`
+}
+
+type AuthzActions struct {
+	View         string `mapstructure:"view" default:"view"`
+	Edit         string `mapstructure:"edit" default:"edit"`
+	Scale        string `mapstructure:"scale" default:"edit"`
+	Delete       string `mapstructure:"delete" default:"delete"`
+	ManageAlerts string `mapstructure:"manage_alerts" default:"edit"`
+}
+
+type authorizer struct {
+	cfg      AuthzConfig
+	client   entropyv1beta1.ResourceServiceClient
+	shield   shieldv1beta1.ShieldServiceClient
+	projects *projectsv1.Resolver
+}
+
+// require returns a handler that serves the request using 'next' only if
+// the user is allowed to perform the action on the project, and on the
+// group of the firehose for firehose-scoped APIs. Requests are served
+// without any checks if authorization is disabled.
+func (az authorizer) require(action string, next http.Handler) http.Handler {
+	if !az.cfg.Enabled {
+		return next
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := az.check(r, action); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (az authorizer) check(r *http.Request, action string) error {
+	rCtx := reqctx.From(r.Context())
+	if rCtx.UserEmail == "" {
+		return errors.ErrForbidden.
+			WithMsgf("user identity is required").
+			WithCausef("request has no user email")
+	}
+
+	prj, err := getProject(r, az.projects)
+	if err != nil {
+		return err
+	}
+
+	ctx := metadata.AppendToOutgoingContext(r.Context(), az.cfg.IdentityHeader, rCtx.UserEmail)
+	if allowed, err := az.checkPermission(ctx, az.cfg.ProjectNamespace, prj.GetId(), action); err != nil {
+		return err
+	} else if !allowed {
+		return errors.ErrForbidden.
+			WithMsgf("user is not allowed to %s firehoses of project '%s'", action, prj.GetSlug()).
+			WithCausef("permission '%s' denied for '%s'", action, rCtx.UserEmail)
+	}
+
+	urn := mux.Vars(r)[pathParamURN]
+	if urn == "" {
+		return nil
+	}
+
+	res, err := getResource(r.Context(), az.client, prj, urn)
+	if err != nil {
+		return err
+	}
+
+	labels, err := toFirehoseLabels(res.GetLabels())
+	if err != nil {
+		return err
+	} else if labels.Group == "" {
+		return nil
+	}
+
+	if allowed, err := az.checkPermission(ctx, az.cfg.GroupNamespace, labels.Group, action); err != nil {
+		return err
+	} else if !allowed {
+		return errors.ErrForbidden.
+			WithMsgf("user is not allowed to %s firehoses of group '%s'", action, labels.Group).
+			WithCausef("permission '%s' on group denied for '%s'", action, rCtx.UserEmail)
+	}
+	return nil
+}
+
+func (az authorizer) checkPermission(ctx context.Context, namespace, id, permission string) (bool, error) {
+	resp, err := az.shield.CheckResourcePermission(ctx, &shieldv1beta1.CheckResourcePermissionRequest{
+		ObjectId:        id,
+		ObjectNamespace: namespace,
+		Permission:      permission,
+	})
+	if err != nil {
+		st := status.Convert(err)
+		if st.Code() == codes.PermissionDenied || st.Code() == codes.Unauthenticated {
+			return false, errors.ErrForbidden.WithCausef(st.Message())
+		}
+		return false, err
+	}
+	return resp.GetStatus(), nil
+}
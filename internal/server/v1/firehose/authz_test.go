@@ -0,0 +1,135 @@
+package firehose
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+)
+
+type fakeShieldClient struct {
+	shieldv1beta1.ShieldServiceClient
+	projects []*shieldv1beta1.Project
+
+	// allowed is the set of "email/permission" pairs that are allowed on
+	// project p1, and "email/group/permission" triples allowed on groups.
+	allowed map[string]bool
+}
+
+func (f *fakeShieldClient) ListProjects(_ context.Context, _ *shieldv1beta1.ListProjectsRequest, _ ...grpc.CallOption) (*shieldv1beta1.ListProjectsResponse, error) {
+	return &shieldv1beta1.ListProjectsResponse{Projects: f.projects}, nil
+}
+
+func (f *fakeShieldClient) CheckResourcePermission(ctx context.Context, in *shieldv1beta1.CheckResourcePermissionRequest, _ ...grpc.CallOption) (*shieldv1beta1.CheckResourcePermissionResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	var email string
+	if vals := md.Get("X-Shield-Email"); len(vals) > 0 {
+		email = vals[0]
+	}
+
+	var allowed bool
+	switch in.GetObjectNamespace() {
+	case "shield/project":
+		allowed = in.GetObjectId() == "p1" && f.allowed[email+"/"+in.GetPermission()]
+	case "shield/group":
+		allowed = f.allowed[email+"/"+in.GetObjectId()+"/"+in.GetPermission()]
+	}
+	return &shieldv1beta1.CheckResourcePermissionResponse{Status: allowed}, nil
+}
+
+func Test_authorizer_require(t *testing.T) {
+	t.Parallel()
+
+	shield := &fakeShieldClient{
+		projects: []*shieldv1beta1.Project{{Id: "p1", Slug: "foo"}},
+		allowed:  map[string]bool{"viewer@odpf.io/view": true},
+	}
+	cfg := AuthzConfig{
+		Enabled:          true,
+		IdentityHeader:   "X-Shield-Email",
+		ProjectNamespace: "shield/project",
+	}
+
+	serve := func(cfg AuthzConfig, email, action string) int {
+		router := mux.NewRouter()
+		router.Use(reqctx.WithRequestCtx())
+
+		az := authorizer{cfg: cfg, shield: shield, projects: projectsv1.NewResolver(shield, projectsv1.CacheConfig{})}
+		router.Handle("/projects/{projectSlug}/firehoses", az.require(action, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		})))
+
+		req := httptest.NewRequest(http.MethodGet, "/projects/foo/firehoses", nil)
+		if email != "" {
+			req.Header.Set("X-Auth-Email", email)
+		}
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve(cfg, "viewer@odpf.io", "view"))
+	assert.Equal(t, http.StatusForbidden, serve(cfg, "viewer@odpf.io", "edit"))
+	assert.Equal(t, http.StatusForbidden, serve(cfg, "", "view"))
+	assert.Equal(t, http.StatusOK, serve(AuthzConfig{}, "", "edit"))
+}
+
+func Test_authorizer_requireGroup(t *testing.T) {
+	t.Parallel()
+
+	shield := &fakeShieldClient{
+		projects: []*shieldv1beta1.Project{{Id: "p1", Slug: "foo"}},
+		allowed: map[string]bool{
+			"viewer@odpf.io/view":    true,
+			"viewer@odpf.io/g1/view": true,
+		},
+	}
+	client := &fakeResourceClient{
+		resources: map[string]*entropyv1beta1.Resource{
+			"f1": {Urn: "f1", Kind: kindFirehose, Project: "foo", Labels: map[string]string{"group": "g1"}},
+			"f2": {Urn: "f2", Kind: kindFirehose, Project: "foo", Labels: map[string]string{"group": "g2"}},
+			"f3": {Urn: "f3", Kind: kindFirehose, Project: "foo"},
+		},
+	}
+	cfg := AuthzConfig{
+		Enabled:          true,
+		IdentityHeader:   "X-Shield-Email",
+		ProjectNamespace: "shield/project",
+		GroupNamespace:   "shield/group",
+	}
+
+	router := mux.NewRouter()
+	router.Use(reqctx.WithRequestCtx())
+
+	az := authorizer{cfg: cfg, client: client, shield: shield, projects: projectsv1.NewResolver(shield, projectsv1.CacheConfig{})}
+	router.Handle("/projects/{projectSlug}/firehoses/{urn}", az.require("view", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})))
+
+	serve := func(urn string) int {
+		req := httptest.NewRequest(http.MethodGet, "/projects/foo/firehoses/"+urn, nil)
+		req.Header.Set("X-Auth-Email", "viewer@odpf.io")
+
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	assert.Equal(t, http.StatusOK, serve("f1"))
+	assert.Equal(t, http.StatusForbidden, serve("f2"))
+	// firehoses without a group need only the project permission.
+	assert.Equal(t, http.StatusOK, serve("f3"))
+	assert.Equal(t, http.StatusNotFound, serve("unknown"))
+}
@@ -0,0 +1,191 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+
+	"github.com/odpf/dex/internal/server/utils"
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+// copyAlertPolicyRequest describes the policy to be applied and the target
+// firehoses to apply it to. Policy is taken either from the source firehose
+// or from the request.
+type copyAlertPolicyRequest struct {
+	SourceURN  string            `json:"source_urn"`
+	Policy     *alertsv1.Policy  `json:"policy"`
+	TargetURNs []string          `json:"target_urns"`
+	Filter     *copyTargetFilter `json:"filter"`
+}
+
+type copyTargetFilter struct {
+	Group    string `json:"group"`
+	SinkType string `json:"sink_type"`
+}
+
+// copyAlertPolicyResult is the outcome of applying the policy to a single
+// target firehose.
+type copyAlertPolicyResult struct {
+	URN    string           `json:"urn"`
+	Policy *alertsv1.Policy `json:"policy,omitempty"`
+	Error  string           `json:"error,omitempty"`
+}
+
+func (req copyAlertPolicyRequest) validate() error {
+	if (req.SourceURN == "") == (req.Policy == nil) {
+		return errors.ErrInvalid.WithMsgf("exactly one of source_urn, policy must be specified")
+	} else if (len(req.TargetURNs) == 0) == (req.Filter == nil) {
+		return errors.ErrInvalid.WithMsgf("exactly one of target_urns, filter must be specified")
+	} else if req.Filter != nil && req.Filter.Group == "" && req.Filter.SinkType == "" {
+		return errors.ErrInvalid.WithMsgf("filter must have at least one of group, sink_type")
+	}
+	return nil
+}
+
+func handleCopyAlertPolicy(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		var req copyAlertPolicyRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			utils.WriteErr(w, errors.ErrInvalid.
+				WithMsgf("request json body is not valid").
+				WithCausef(err.Error()))
+			return
+		} else if err := req.validate(); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		policy, err := copySourcePolicy(ctx, client, svc, prj, req)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		targets, err := copyTargets(ctx, client, prj, req)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		results := []copyAlertPolicyResult{}
+		for _, t := range targets {
+			results = append(results, copyAlertPolicyTo(ctx, client, svc, prj, t, policy))
+		}
+
+		utils.WriteJSON(w, http.StatusOK, listResponse[copyAlertPolicyResult]{Items: results})
+	}
+}
+
+// copySourcePolicy returns the policy to be copied with the supplied
+// variables removed, so that they can be rebound for each target.
+func copySourcePolicy(ctx context.Context, client entropyv1beta1.ResourceServiceClient, svc *alertsv1.Service, prj *shieldv1beta1.Project, req copyAlertPolicyRequest) (alertsv1.Policy, error) {
+	if req.Policy != nil {
+		return alertsv1.Policy{Rules: removeSuppliedVariablesFromRules(req.Policy.Rules, suppliedAlertVariableNames)}, nil
+	}
+
+	firehoseDef, err := getFirehoseResource(ctx, client, prj, req.SourceURN)
+	if err != nil {
+		return alertsv1.Policy{}, err
+	}
+
+	name, err := getFirehoseReleaseName(firehoseDef)
+	if err != nil {
+		return alertsv1.Policy{}, err
+	}
+
+	policy, err := svc.GetAlertPolicy(ctx, prj.GetSlug(), name)
+	if err != nil {
+		return alertsv1.Policy{}, err
+	}
+	return alertsv1.Policy{Rules: removeSuppliedVariablesFromRules(policy.Rules, suppliedAlertVariableNames)}, nil
+}
+
+// copyTargets returns the target firehoses. Firehoses selected by URN are
+// looked up only when the policy is being applied to them, so that a bad
+// URN fails only its own copy. The source firehose is never a target.
+func copyTargets(ctx context.Context, client entropyv1beta1.ResourceServiceClient, prj *shieldv1beta1.Project, req copyAlertPolicyRequest) ([]firehoseDefinition, error) {
+	var targets []firehoseDefinition
+	if req.Filter == nil {
+		seen := map[string]bool{req.SourceURN: true}
+		for _, urn := range req.TargetURNs {
+			urn = strings.TrimSpace(urn)
+			if urn != "" && !seen[urn] {
+				seen[urn] = true
+				targets = append(targets, firehoseDefinition{URN: urn})
+			}
+		}
+		return targets, nil
+	}
+
+	resp, err := client.ListResources(ctx, &entropyv1beta1.ListResourcesRequest{
+		Kind:    kindFirehose,
+		Project: prj.GetSlug(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filter := listFilter{
+		Group:    strings.TrimSpace(req.Filter.Group),
+		SinkType: strings.ToUpper(strings.TrimSpace(req.Filter.SinkType)),
+	}
+	for _, res := range resp.GetResources() {
+		firehoseDef, err := mapResourceToFirehose(res, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if firehoseDef.URN != req.SourceURN && filter.matches(*firehoseDef) {
+			targets = append(targets, *firehoseDef)
+		}
+	}
+	return targets, nil
+}
+
+func copyAlertPolicyTo(ctx context.Context, client entropyv1beta1.ResourceServiceClient, svc *alertsv1.Service,
+	prj *shieldv1beta1.Project, firehoseDef firehoseDefinition, policy alertsv1.Policy,
+) copyAlertPolicyResult {
+	res := copyAlertPolicyResult{URN: firehoseDef.URN}
+
+	if firehoseDef.State == nil {
+		// selected by URN and not looked up yet.
+		def, err := getFirehoseResource(ctx, client, prj, firehoseDef.URN)
+		if err != nil {
+			res.Error = err.Error()
+			return res
+		}
+		firehoseDef = *def
+	}
+
+	name, err := getFirehoseReleaseName(&firehoseDef)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+
+	target := &alertTarget{prj: prj, firehoseDef: &firehoseDef, name: name}
+	updated, err := applyAlertPolicy(ctx, svc, target, policy)
+	if err != nil {
+		res.Error = err.Error()
+		return res
+	}
+
+	updated.Rules = removeSuppliedVariablesFromRules(updated.Rules, suppliedAlertVariableNames)
+	res.Policy = updated
+	return res
+}
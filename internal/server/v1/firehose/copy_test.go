@@ -0,0 +1,76 @@
+package firehose
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+)
+
+func Test_copyAlertPolicyRequest_validate(t *testing.T) {
+	t.Parallel()
+
+	policy := &alertsv1.Policy{}
+	filter := &copyTargetFilter{Group: "pricing"}
+
+	assert.NoError(t, copyAlertPolicyRequest{SourceURN: "fh-1", TargetURNs: []string{"fh-2"}}.validate())
+	assert.NoError(t, copyAlertPolicyRequest{Policy: policy, Filter: filter}.validate())
+
+	assert.Error(t, copyAlertPolicyRequest{TargetURNs: []string{"fh-2"}}.validate())
+	assert.Error(t, copyAlertPolicyRequest{SourceURN: "fh-1", Policy: policy, TargetURNs: []string{"fh-2"}}.validate())
+	assert.Error(t, copyAlertPolicyRequest{SourceURN: "fh-1"}.validate())
+	assert.Error(t, copyAlertPolicyRequest{SourceURN: "fh-1", TargetURNs: []string{"fh-2"}, Filter: filter}.validate())
+	assert.Error(t, copyAlertPolicyRequest{SourceURN: "fh-1", Filter: &copyTargetFilter{}}.validate())
+}
+
+func Test_copyTargets(t *testing.T) {
+	t.Parallel()
+
+	configs, err := toProtobufStruct(moduleConfig{})
+	require.NoError(t, err)
+	spec := &entropyv1beta1.ResourceSpec{Configs: configs}
+
+	newResource := func(urn, group string) *entropyv1beta1.Resource {
+		return &entropyv1beta1.Resource{
+			Urn: urn, Kind: kindFirehose, Project: "a", Name: urn, Spec: spec,
+			Labels: map[string]string{"group": group},
+			State:  &entropyv1beta1.ResourceState{},
+		}
+	}
+	client := &fakeResourceClient{
+		resources: map[string]*entropyv1beta1.Resource{
+			"fh-1": newResource("fh-1", "pricing"),
+			"fh-2": newResource("fh-2", "pricing"),
+			"fh-3": newResource("fh-3", "booking"),
+		},
+	}
+	prj := &shieldv1beta1.Project{Slug: "a"}
+
+	t.Run("ByURN", func(t *testing.T) {
+		t.Parallel()
+
+		targets, err := copyTargets(context.Background(), client, prj, copyAlertPolicyRequest{
+			SourceURN:  "fh-1",
+			TargetURNs: []string{"fh-2", "fh-1", " fh-2 ", "unknown"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []firehoseDefinition{{URN: "fh-2"}, {URN: "unknown"}}, targets)
+	})
+
+	t.Run("ByFilter", func(t *testing.T) {
+		t.Parallel()
+
+		targets, err := copyTargets(context.Background(), client, prj, copyAlertPolicyRequest{
+			SourceURN: "fh-1",
+			Filter:    &copyTargetFilter{Group: "pricing"},
+		})
+		require.NoError(t, err)
+		require.Len(t, targets, 1)
+		assert.Equal(t, "fh-2", targets[0].URN)
+	})
+}
@@ -0,0 +1,209 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"go.uber.org/zap"
+
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	queryParamSkipDefaultAlerts = "skip_default_alerts"
+
+	// projectMetadataDefaultAlertPolicy is the key of the default alert
+	// policy in the Shield project metadata.
+	projectMetadataDefaultAlertPolicy = "default_alert_policy"
+
+	// defaultAlertsPending marks the firehoses the default alert policy is
+	// yet to be applied to.
+	defaultAlertsPending = "pending"
+
+	defaultAlertsInterval = time.Minute
+)
+
+// createFirehoseResult is the created firehose along with the report of the
+// default alert policy of the project applied to it.
+type createFirehoseResult struct {
+	firehoseDefinition
+	DefaultAlerts *defaultAlertsReport `json:"default_alerts,omitempty"`
+}
+
+type defaultAlertsReport struct {
+	Applied []string `json:"applied"`
+	Error   string   `json:"error,omitempty"`
+
+	// pending is set if the policy could not be applied since the release
+	// name of the firehose is not known yet.
+	pending bool
+}
+
+func skipDefaultAlerts(r *http.Request) (bool, error) {
+	s := r.URL.Query().Get(queryParamSkipDefaultAlerts)
+	if s == "" {
+		return false, nil
+	}
+
+	skip, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, errors.ErrInvalid.
+			WithMsgf("%s must be a boolean", queryParamSkipDefaultAlerts).
+			WithCausef("invalid %s '%s'", queryParamSkipDefaultAlerts, s)
+	}
+	return skip, nil
+}
+
+// defaultAlertPolicy returns the default alert policy from the project
+// metadata. Returns nil if the project does not have one.
+func defaultAlertPolicy(prj *shieldv1beta1.Project) (*alertsv1.Policy, error) {
+	v, found := prj.GetMetadata().AsMap()[projectMetadataDefaultAlertPolicy]
+	if !found || v == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var policy alertsv1.Policy
+	if err := json.Unmarshal(b, &policy); err != nil {
+		return nil, errors.ErrInternal.
+			WithMsgf("default alert policy of project '%s' is not valid", prj.GetSlug()).
+			WithCausef(err.Error())
+	}
+	return &policy, nil
+}
+
+// applyDefaultAlertPolicy applies the default alert policy of the project to
+// the newly created firehose. The firehose is already created at this point,
+// so failures are reported instead of being returned. Returns nil if the
+// project does not have a default alert policy.
+func applyDefaultAlertPolicy(ctx context.Context, svc *alertsv1.Service, prj *shieldv1beta1.Project, firehoseDef *firehoseDefinition) *defaultAlertsReport {
+	policy, err := defaultAlertPolicy(prj)
+	if err != nil {
+		return &defaultAlertsReport{Applied: []string{}, Error: err.Error()}
+	} else if policy == nil {
+		return nil
+	}
+
+	name, err := getFirehoseReleaseName(firehoseDef)
+	if err != nil {
+		return &defaultAlertsReport{
+			Applied: []string{},
+			Error:   "release name of the firehose is not known yet, policy will be applied once the firehose is deployed",
+			pending: true,
+		}
+	}
+
+	target := &alertTarget{prj: prj, firehoseDef: firehoseDef, name: name}
+	updated, err := applyAlertPolicy(ctx, svc, target, *policy)
+	if err != nil {
+		return &defaultAlertsReport{Applied: []string{}, Error: err.Error()}
+	}
+
+	applied := []string{}
+	for _, rule := range updated.Rules {
+		if rule.Enabled {
+			applied = append(applied, rule.Template)
+		}
+	}
+	return &defaultAlertsReport{Applied: applied}
+}
+
+// markDefaultAlertsPending labels the newly created firehose so that the
+// default alert policy is applied once its release name is known.
+func markDefaultAlertsPending(ctx context.Context, client entropyv1beta1.ResourceServiceClient, res *entropyv1beta1.Resource) error {
+	firehoseDef, err := mapResourceToFirehose(res, true)
+	if err != nil {
+		return err
+	}
+
+	labels := firehoseDef.getLabels()
+	labels.DefaultAlerts = defaultAlertsPending
+	return updateResourceLabels(ctx, client, res, labels)
+}
+
+// ApplyPendingDefaultAlerts periodically applies the default alert policy
+// of the project to the firehoses marked by markDefaultAlertsPending, once
+// their release names are known, until the context is cancelled. Applying
+// the policy is idempotent, so replicas running this concurrently at most
+// repeat the work.
+func ApplyPendingDefaultAlerts(ctx context.Context, client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver,
+	svc *alertsv1.Service, logger *zap.Logger,
+) {
+	ticker := time.NewTicker(defaultAlertsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			// failures are retried on the next tick.
+			applyAllPendingDefaultAlerts(ctx, client, projects, svc, logger)
+		}
+	}
+}
+
+func applyAllPendingDefaultAlerts(ctx context.Context, client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver,
+	svc *alertsv1.Service, logger *zap.Logger,
+) {
+	resp, err := client.ListResources(ctx, &entropyv1beta1.ListResourcesRequest{Kind: kindFirehose})
+	if err != nil {
+		logger.Error("failed to list firehoses for default alerts", zap.Error(err))
+		return
+	}
+
+	for _, res := range resp.GetResources() {
+		labels, err := toFirehoseLabels(res.GetLabels())
+		if err != nil || labels.DefaultAlerts != defaultAlertsPending {
+			continue
+		} else if _, deployed := getResourceReleaseName(res); !deployed {
+			continue
+		}
+
+		err = applyPendingDefaultAlerts(ctx, client, projects, svc, res)
+		if err != nil {
+			logger.Error("failed to apply default alert policy",
+				zap.String("urn", res.GetUrn()), zap.Error(err))
+		}
+	}
+}
+
+// applyPendingDefaultAlerts applies the default alert policy of the project
+// to the deployed firehose marked by markDefaultAlertsPending, and removes
+// the mark.
+func applyPendingDefaultAlerts(ctx context.Context, client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver,
+	svc *alertsv1.Service, res *entropyv1beta1.Resource,
+) error {
+	prj, err := projects.BySlug(ctx, res.GetProject())
+	if err != nil {
+		return err
+	}
+
+	firehoseDef, err := mapResourceToFirehose(res, false)
+	if err != nil {
+		return err
+	}
+
+	report := applyDefaultAlertPolicy(ctx, svc, prj, firehoseDef)
+	if report != nil && report.Error != "" {
+		return errors.ErrInternal.
+			WithMsgf("failed to apply default alert policy").
+			WithCausef(report.Error)
+	}
+
+	labels := firehoseDef.getLabels()
+	labels.DefaultAlerts = ""
+	return updateResourceLabels(ctx, client, res, labels)
+}
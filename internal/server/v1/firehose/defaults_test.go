@@ -0,0 +1,110 @@
+package firehose
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+)
+
+func Test_defaultAlertPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("NotSet", func(t *testing.T) {
+		t.Parallel()
+
+		policy, err := defaultAlertPolicy(&shieldv1beta1.Project{Slug: "foo"})
+		require.NoError(t, err)
+		assert.Nil(t, policy)
+	})
+
+	t.Run("Set", func(t *testing.T) {
+		t.Parallel()
+
+		metadata, err := structpb.NewStruct(map[string]interface{}{
+			"telegraf": map[string]interface{}{"enabled": true},
+			projectMetadataDefaultAlertPolicy: map[string]interface{}{
+				"rules": []interface{}{
+					map[string]interface{}{
+						"Template":  "firehose-lag",
+						"enabled":   true,
+						"variables": []interface{}{map[string]interface{}{"name": "warn", "value": "100"}},
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		policy, err := defaultAlertPolicy(&shieldv1beta1.Project{Slug: "foo", Metadata: metadata})
+		require.NoError(t, err)
+		assert.Equal(t, &alertsv1.Policy{
+			Rules: []alertsv1.Rule{
+				{Template: "firehose-lag", Enabled: true, Variables: []alertsv1.Variable{{Name: "warn", Value: "100"}}},
+			},
+		}, policy)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		metadata, err := structpb.NewStruct(map[string]interface{}{
+			projectMetadataDefaultAlertPolicy: map[string]interface{}{"rules": "firehose-lag"},
+		})
+		require.NoError(t, err)
+
+		_, err = defaultAlertPolicy(&shieldv1beta1.Project{Slug: "foo", Metadata: metadata})
+		assert.Error(t, err)
+	})
+}
+
+func Test_markDefaultAlertsPending(t *testing.T) {
+	t.Parallel()
+
+	configs, err := toProtobufStruct(moduleConfig{State: stateRunning})
+	require.NoError(t, err)
+
+	// created, but not deployed yet.
+	res := &entropyv1beta1.Resource{
+		Urn:     "fh-1",
+		Kind:    kindFirehose,
+		Project: "foo",
+		Labels:  map[string]string{"title": "fh-1"},
+		Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+	}
+	client := &fakeLabelsClient{
+		fakeResourceClient: fakeResourceClient{
+			resources: map[string]*entropyv1beta1.Resource{"fh-1": res},
+		},
+	}
+
+	require.NoError(t, markDefaultAlertsPending(context.Background(), client, res))
+	require.Len(t, client.updates, 1)
+	assert.Equal(t, defaultAlertsPending, client.updates[0].Labels["default_alerts"])
+	assert.Equal(t, "fh-1", client.updates[0].Labels["title"])
+
+	shield := &fakeShieldClient{projects: []*shieldv1beta1.Project{{Id: "p1", Slug: "foo"}}}
+	projects := projectsv1.NewResolver(shield, projectsv1.CacheConfig{})
+
+	// release name is still not known, so the policy stays pending.
+	res.Labels = client.updates[0].Labels
+	applyAllPendingDefaultAlerts(context.Background(), client, projects, nil, zap.NewNop())
+	assert.Len(t, client.updates, 1)
+
+	// project has no default alert policy anymore, so only the mark is removed.
+	output, err := structpb.NewValue(map[string]interface{}{firehoseOutputReleaseNameKey: "fh-1-firehose"})
+	require.NoError(t, err)
+	res.State = &entropyv1beta1.ResourceState{Output: output}
+
+	applyAllPendingDefaultAlerts(context.Background(), client, projects, nil, zap.NewNop())
+	require.Len(t, client.updates, 2)
+	assert.NotContains(t, client.updates[1].Labels, "default_alerts")
+	assert.Equal(t, "fh-1", client.updates[1].Labels["title"])
+}
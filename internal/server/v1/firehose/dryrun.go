@@ -0,0 +1,92 @@
+package firehose
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const queryParamDryRun = "dry_run"
+
+// dryRunResult is the would-be firehose returned for dry-run requests,
+// along with the diff against the current spec.
+type dryRunResult struct {
+	firehoseDefinition
+	DryRun dryRunInfo `json:"dry_run"`
+}
+
+type dryRunInfo struct {
+	Diff json.RawMessage `json:"diff"`
+}
+
+func isDryRun(r *http.Request) (bool, error) {
+	s := r.URL.Query().Get(queryParamDryRun)
+	if s == "" {
+		return false, nil
+	}
+
+	dryRun, err := strconv.ParseBool(s)
+	if err != nil {
+		return false, errors.ErrInvalid.
+			WithMsgf("%s must be a boolean", queryParamDryRun).
+			WithCausef("invalid %s '%s'", queryParamDryRun, s)
+	}
+	return dryRun, nil
+}
+
+// withSpec returns a copy of the resource with the configs and labels
+// replaced. Nil labels retains the existing labels.
+func withSpec(res *entropyv1beta1.Resource, configs *structpb.Value, labels map[string]string) *entropyv1beta1.Resource {
+	next := proto.Clone(res).(*entropyv1beta1.Resource)
+	if next.Spec == nil {
+		next.Spec = &entropyv1beta1.ResourceSpec{}
+	}
+	next.Spec.Configs = configs
+	if labels != nil {
+		next.Labels = labels
+	}
+	return next
+}
+
+// buildDryRunResult maps the would-be resource to firehose and computes
+// the diff of its spec against the current resource. 'cur' must be nil
+// when the resource does not exist yet.
+func buildDryRunResult(cur, next *entropyv1beta1.Resource) (*dryRunResult, error) {
+	marshaller := protojson.MarshalOptions{UseProtoNames: true}
+
+	prevSpec := []byte("{}")
+	if cur != nil {
+		b, err := marshaller.Marshal(cur.GetSpec())
+		if err != nil {
+			return nil, errors.ErrInternal.WithCausef(err.Error())
+		}
+		prevSpec = b
+	}
+
+	nextSpec, err := marshaller.Marshal(next.GetSpec())
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef(err.Error())
+	}
+
+	specDiff, err := jsonDiff(prevSpec, nextSpec)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef(err.Error())
+	}
+
+	def, err := mapResourceToFirehose(next, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dryRunResult{
+		firehoseDefinition: *def,
+		DryRun:             dryRunInfo{Diff: json.RawMessage(specDiff)},
+	}, nil
+}
@@ -0,0 +1,32 @@
+package firehose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+)
+
+func Test_buildDryRunResult(t *testing.T) {
+	t.Parallel()
+
+	configs, err := toProtobufStruct(moduleConfig{State: stateRunning})
+	require.NoError(t, err)
+
+	// resource of a dry-run create is not saved yet and has no state.
+	next := &entropyv1beta1.Resource{
+		Urn:     "orn:entropy:firehose:a:fh-1",
+		Kind:    kindFirehose,
+		Project: "a",
+		Name:    "fh-1",
+		Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+	}
+
+	result, err := buildDryRunResult(nil, next)
+	require.NoError(t, err)
+	assert.Equal(t, next.Urn, result.URN)
+	assert.Equal(t, stateRunning, result.State.State)
+	assert.Empty(t, result.State.Output)
+	assert.NotEmpty(t, result.DryRun.Diff)
+}
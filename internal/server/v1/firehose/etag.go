@@ -0,0 +1,72 @@
+package firehose
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	headerETag    = "ETag"
+	headerIfMatch = "If-Match"
+)
+
+// firehoseETag returns the entity-tag of the firehose version last updated
+// at the given time. Entropy bumps the update time of the resource on every
+// change, so it identifies the version uniquely.
+func firehoseETag(updatedAt time.Time) string {
+	return fmt.Sprintf(`"%x"`, updatedAt.UTC().UnixNano())
+}
+
+func setETag(w http.ResponseWriter, updatedAt time.Time) {
+	w.Header().Set(headerETag, firehoseETag(updatedAt))
+}
+
+// checkIfMatch returns ErrPreconditionFailed if the request carries an
+// If-Match header and none of the entity-tags in it match the current
+// version of the firehose. Requests without If-Match are always allowed.
+// Weak entity-tags (W/"...") are compared by their opaque tag, since every
+// tag issued for firehoses identifies the version exactly.
+func checkIfMatch(r *http.Request, updatedAt time.Time) error {
+	ifMatch := strings.TrimSpace(r.Header.Get(headerIfMatch))
+	if ifMatch == "" || ifMatch == "*" {
+		return nil
+	}
+
+	cur := firehoseETag(updatedAt)
+	for _, tag := range strings.Split(ifMatch, ",") {
+		if strings.TrimPrefix(strings.TrimSpace(tag), "W/") == cur {
+			return nil
+		}
+	}
+
+	return errors.ErrPreconditionFailed.
+		WithMsgf("firehose was modified since it was read, fetch the latest version and retry").
+		WithCausef("If-Match '%s' does not match current ETag '%s'", ifMatch, cur)
+}
+
+// recheckIfMatch re-reads the firehose and runs checkIfMatch against it. It
+// is meant to be called right before writing a change, so that changes made
+// while the request was being processed are caught. Entropy has no
+// conditional update, so a change landing between this read and the write
+// still goes unnoticed.
+func recheckIfMatch(ctx context.Context, r *http.Request, client entropyv1beta1.ResourceServiceClient,
+	prj *shieldv1beta1.Project, urn string,
+) error {
+	if strings.TrimSpace(r.Header.Get(headerIfMatch)) == "" {
+		return nil
+	}
+
+	latest, err := getResource(ctx, client, prj, urn)
+	if err != nil {
+		return err
+	}
+	return checkIfMatch(r, latest.GetUpdatedAt().AsTime())
+}
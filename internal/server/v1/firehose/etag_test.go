@@ -0,0 +1,73 @@
+package firehose
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func Test_checkIfMatch(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2022, 10, 10, 10, 10, 10, 0, time.UTC)
+	cur := firehoseETag(updatedAt)
+	stale := firehoseETag(updatedAt.Add(-time.Second))
+
+	table := map[string]error{
+		"":                  nil,
+		"*":                 nil,
+		cur:                 nil,
+		stale + ", " + cur:  nil,
+		"W/" + cur:          nil,
+		stale:               errors.ErrPreconditionFailed,
+		`"not-a-real-etag"`: errors.ErrPreconditionFailed,
+	}
+
+	for ifMatch, want := range table {
+		r := httptest.NewRequest("PUT", "/", nil)
+		if ifMatch != "" {
+			r.Header.Set(headerIfMatch, ifMatch)
+		}
+
+		err := checkIfMatch(r, updatedAt)
+		if want == nil {
+			assert.NoError(t, err, ifMatch)
+		} else {
+			assert.ErrorIs(t, err, want, ifMatch)
+		}
+	}
+}
+
+func Test_recheckIfMatch(t *testing.T) {
+	t.Parallel()
+
+	readAt := time.Date(2022, 10, 10, 10, 10, 10, 0, time.UTC)
+	client := &fakeResourceClient{
+		resources: map[string]*entropyv1beta1.Resource{
+			// updated after the request has read it.
+			"orn:foo:firehose:a:f1": {
+				Urn:       "orn:foo:firehose:a:f1",
+				Kind:      kindFirehose,
+				Project:   "a",
+				UpdatedAt: timestamppb.New(readAt.Add(time.Second)),
+			},
+		},
+	}
+	prj := &shieldv1beta1.Project{Slug: "a"}
+
+	r := httptest.NewRequest("PUT", "/", nil)
+	require.NoError(t, recheckIfMatch(context.Background(), r, client, prj, "orn:foo:firehose:a:unknown"))
+
+	r.Header.Set(headerIfMatch, firehoseETag(readAt))
+	require.NoError(t, checkIfMatch(r, readAt))
+	assert.ErrorIs(t, recheckIfMatch(context.Background(), r, client, prj, "orn:foo:firehose:a:f1"), errors.ErrPreconditionFailed)
+}
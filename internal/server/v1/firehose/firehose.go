@@ -6,14 +6,16 @@ import (
 	"github.com/gorilla/mux"
 	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
 	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
-	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
 
 	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
 )
 
 const (
 	pathParamURN         = "urn"
 	pathParamProjectSlug = "projectSlug"
+	pathParamSinkType    = "sinkType"
+	pathParamRevision    = "revision"
 
 	kindFirehose = "firehose"
 
@@ -21,37 +23,56 @@ const (
 	actionScale       = "scale"
 	actionStart       = "start"
 	actionResetOffset = "reset"
-
-	// shield header names.
-	// Refer https://github.com/odpf/shield
-	headerProjectID = "X-Shield-Project"
 )
 
 func Routes(r *mux.Router, client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient,
-	sirenClient sirenv1beta1.SirenServiceClient, latestFirehoseVersion string,
+	projects *projectsv1.Resolver, alertSvc *alertsv1.Service, latestFirehoseVersion string, authzCfg AuthzConfig,
 ) {
-	alertSvc := &alertsv1.Service{Siren: sirenClient}
+	az := authorizer{cfg: authzCfg, client: client, shield: shieldClient, projects: projects}
+	actions := authzCfg.Actions
 
 	// read APIs
-	r.Handle("/projects/{projectSlug}/firehoses", handleListFirehoses(client, shieldClient)).Methods(http.MethodGet)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}", handleGetFirehose(client)).Methods(http.MethodGet)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/history", handleGetFirehoseHistory(client)).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses", az.require(actions.View, handleListFirehoses(client, projects))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}", az.require(actions.View, handleGetFirehose(client, projects))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/history", az.require(actions.View, handleGetFirehoseHistory(client, projects))).Methods(http.MethodGet)
 
 	// write APIs
-	r.Handle("/projects/{projectSlug}/firehoses", handleCreateFirehose(client, shieldClient)).Methods(http.MethodPost)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}", handleUpdateFirehose(client, shieldClient)).Methods(http.MethodPut)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}", handleDeleteFirehose(client)).Methods(http.MethodDelete)
+	r.Handle("/projects/{projectSlug}/firehoses", az.require(actions.Edit, handleCreateFirehose(client, projects, alertSvc))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}", az.require(actions.Edit, handleUpdateFirehose(client, projects, alertSvc))).Methods(http.MethodPut)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}", az.require(actions.Edit, handlePatchFirehose(client, projects, alertSvc))).Methods(http.MethodPatch)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}", az.require(actions.Delete, handleDeleteFirehose(client, projects, alertSvc))).Methods(http.MethodDelete)
 
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/reset", handleResetFirehose(client)).Methods(http.MethodPost)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/scale", handleScaleFirehose(client)).Methods(http.MethodPost)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/start", handleStartOrStop(client, shieldClient, alertSvc, false)).Methods(http.MethodPost)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/stop", handleStartOrStop(client, shieldClient, alertSvc, true)).Methods(http.MethodPost)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/upgrade", handleUpgradeFirehose(client, shieldClient, latestFirehoseVersion)).Methods(http.MethodPost)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/logs", handleGetFirehoseLogs(client)).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/reset", az.require(actions.Edit, handleResetFirehose(client, projects))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/scale", az.require(actions.Scale, handleScaleFirehose(client, projects))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/start", az.require(actions.Edit, handleStartOrStop(client, projects, alertSvc, false))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/stop", az.require(actions.Edit, handleStartOrStop(client, projects, alertSvc, true))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/upgrade", az.require(actions.Edit, handleUpgradeFirehose(client, projects, latestFirehoseVersion))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/logs", az.require(actions.View, handleGetFirehoseLogs(client, projects))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/history/{revision}/rollback", az.require(actions.Edit, handleRollbackFirehose(client, projects))).Methods(http.MethodPost)
 
 	// alert APIs
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy", handleGetFirehoseAlertPolicies(client, shieldClient, alertSvc)).Methods(http.MethodGet)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy", handleUpsertFirehoseAlertPolicies(client, shieldClient, alertSvc)).Methods(http.MethodPut)
-	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alerts", handleListFirehoseAlerts(client, shieldClient, alertSvc)).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy", az.require(actions.View, handleGetFirehoseAlertPolicies(client, projects, alertSvc))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy", az.require(actions.ManageAlerts, handleUpsertFirehoseAlertPolicies(client, projects, alertSvc))).Methods(http.MethodPut)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy/rules/{template:[^/:]+}", az.require(actions.View, handleGetFirehoseAlertRule(client, projects, alertSvc))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy/rules/{template:[^/:]+}", az.require(actions.ManageAlerts, handleUpsertFirehoseAlertRule(client, projects, alertSvc))).Methods(http.MethodPut)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy/rules/{template:[^/:]+}", az.require(actions.ManageAlerts, handleSetFirehoseAlertRuleEnabled(client, projects, alertSvc, false))).Methods(http.MethodDelete)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy/rules/{template:[^/:]+}:enable", az.require(actions.ManageAlerts, handleSetFirehoseAlertRuleEnabled(client, projects, alertSvc, true))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alertPolicy/rules/{template:[^/:]+}:disable", az.require(actions.ManageAlerts, handleSetFirehoseAlertRuleEnabled(client, projects, alertSvc, false))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alerts", az.require(actions.View, handleListFirehoseAlerts(client, projects, alertSvc))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alerts/silences", az.require(actions.View, handleListAlertSilences(client, projects))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alerts/silences", az.require(actions.ManageAlerts, handleCreateAlertSilence(client, projects, alertSvc))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alerts/silences/{silenceID}", az.require(actions.ManageAlerts, handleDeleteAlertSilence(client, projects, alertSvc))).Methods(http.MethodDelete)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alerts/subscriptions", az.require(actions.View, handleListFirehoseSubscriptions(client, projects, alertSvc))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alerts/subscriptions", az.require(actions.ManageAlerts, handleCreateFirehoseSubscription(client, projects, alertSvc))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/firehoses/{urn}/alerts/subscriptions/{subscriptionID}", az.require(actions.ManageAlerts, handleDeleteFirehoseSubscription(client, projects, alertSvc))).Methods(http.MethodDelete)
+	r.Handle("/projects/{projectSlug}/alerts", az.require(actions.View, handleListProjectAlerts(client, projects, alertSvc))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/alertReceivers", az.require(actions.View, handleListAlertReceivers(projects, alertSvc))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/alertPolicy:copy", az.require(actions.ManageAlerts, handleCopyAlertPolicy(client, projects, alertSvc))).Methods(http.MethodPost)
+	r.Handle("/projects/{projectSlug}/orphanedAlertPolicies", az.require(actions.View, handleListOrphanedAlertPolicies(client, projects, alertSvc))).Methods(http.MethodGet)
+	r.Handle("/projects/{projectSlug}/orphanedAlertPolicies", az.require(actions.ManageAlerts, handlePurgeOrphanedAlertPolicies(client, projects, alertSvc))).Methods(http.MethodDelete)
 	r.Handle("/alertTemplates", alertsv1.HandleListAlertTemplates(alertSvc, kindFirehose, suppliedAlertVariableNames)).Methods(http.MethodGet)
+
+	// sink-type APIs
+	r.Handle("/sinkTypes", handleListSinkTypes(latestFirehoseVersion)).Methods(http.MethodGet)
+	r.Handle("/sinkTypes/{sinkType}/schema", handleGetSinkTypeSchema(latestFirehoseVersion)).Methods(http.MethodGet)
 }
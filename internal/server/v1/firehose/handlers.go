@@ -3,8 +3,10 @@ package firehose
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -17,16 +19,20 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/odpf/dex/internal/server/reqctx"
 	"github.com/odpf/dex/internal/server/utils"
 	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
 	"github.com/odpf/dex/pkg/errors"
 )
 
 const (
 	firehoseNotFound             = "no firehose with given URN"
 	firehoseOutputReleaseNameKey = "release_name"
+
+	headerWarning = "Warning"
 )
 
 var (
@@ -35,12 +41,17 @@ var (
 )
 
 type listResponse[T any] struct {
-	Items []T `json:"items"`
+	Items         []T    `json:"items"`
+	NextPageToken string `json:"next_page_token,omitempty"`
 }
 
 type updateRequestBody struct {
 	Description string          `json:"description"`
 	Configs     firehoseConfigs `json:"configs"`
+
+	// State is the desired run state. Current state is retained
+	// if not set.
+	State string `json:"state,omitempty"`
 }
 
 type resetRequestBody struct {
@@ -48,9 +59,34 @@ type resetRequestBody struct {
 	DateTime *time.Time `json:"date_time"`
 }
 
-func handleListFirehoses(client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient) http.HandlerFunc {
+// validate checks the update of the current configs.
+func (ur *updateRequestBody) validate(cur *firehoseConfigs) error {
+	ur.State = strings.ToUpper(strings.TrimSpace(ur.State))
+	if ur.State != "" && ur.State != stateRunning && ur.State != stateStopped {
+		return errors.ErrInvalid.
+			WithMsgf("firehose configs are not valid").
+			WithDetails([]errors.FieldError{
+				{Field: "state", Reason: fmt.Sprintf("value must be one of %s, %s", stateRunning, stateStopped)},
+			})
+	}
+	return ur.Configs.validateUpdate(cur)
+}
+
+func handleListFirehoses(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prj, err := getProject(r, shieldClient)
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		filter, err := parseListFilter(r)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		page, err := parseListPage(r)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -69,22 +105,60 @@ func handleListFirehoses(client entropyv1beta1.ResourceServiceClient, shieldClie
 
 		var arr []firehoseDefinition
 		for _, res := range rpcResp.GetResources() {
-			firehoseDef, err := mapResourceToFirehose(res, true)
+			// configs & state are needed for evaluating the filters. These
+			// are stripped off before responding unless full view is asked.
+			firehoseDef, err := mapResourceToFirehose(res, false)
 			if err != nil {
 				utils.WriteErr(w, err)
 				return
 			}
+
+			if !filter.matches(*firehoseDef) {
+				continue
+			}
+
+			if !page.needsFullView() {
+				firehoseDef.Configs = nil
+				firehoseDef.State = nil
+			}
 			arr = append(arr, *firehoseDef)
 		}
 
-		resp := listResponse[firehoseDefinition]{Items: arr}
+		sortFirehoses(arr, page.SortBy, page.Descending)
+		arr, nextPageToken := page.paginate(arr)
+
+		if len(page.Fields) == 0 {
+			resp := listResponse[firehoseDefinition]{Items: arr, NextPageToken: nextPageToken}
+			utils.WriteJSON(w, http.StatusOK, resp)
+			return
+		}
+
+		projected, err := projectFields(arr, page.Fields)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		resp := listResponse[map[string]interface{}]{Items: projected, NextPageToken: nextPageToken}
 		utils.WriteJSON(w, http.StatusOK, resp)
 	}
 }
 
-func handleCreateFirehose(client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient) http.HandlerFunc {
+func handleCreateFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prj, err := getProject(r, shieldClient)
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		dryRun, err := isDryRun(r)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		skipDefaults, err := skipDefaultAlerts(r)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -98,12 +172,30 @@ func handleCreateFirehose(client entropyv1beta1.ResourceServiceClient, shieldCli
 			return
 		}
 
+		if def.Configs == nil {
+			utils.WriteErr(w, errors.ErrInvalid.WithMsgf("configs must be specified"))
+			return
+		} else if err := def.Configs.validate(); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		res, err := mapFirehoseToResource(reqctx.From(r.Context()), def, prj)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
+		if dryRun {
+			result, err := buildDryRunResult(nil, res)
+			if err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+			utils.WriteJSON(w, http.StatusOK, result)
+			return
+		}
+
 		rpcReq := &entropyv1beta1.CreateResourceRequest{Resource: res}
 		rpcResp, err := client.CreateResource(r.Context(), rpcReq)
 		if err != nil {
@@ -124,30 +216,68 @@ func handleCreateFirehose(client entropyv1beta1.ResourceServiceClient, shieldCli
 			return
 		}
 
-		utils.WriteJSON(w, http.StatusCreated, createdFirehose)
+		result := createFirehoseResult{firehoseDefinition: *createdFirehose}
+		if !skipDefaults {
+			result.DefaultAlerts = applyDefaultAlertPolicy(r.Context(), svc, prj, createdFirehose)
+		}
+
+		if result.DefaultAlerts != nil && result.DefaultAlerts.pending {
+			if err := markDefaultAlertsPending(r.Context(), client, rpcResp.GetResource()); err != nil {
+				result.DefaultAlerts.Error = fmt.Sprintf("release name of the firehose is not known yet and policy could not be deferred: %v", err)
+			} else if marked, err := getFirehoseResource(r.Context(), client, prj, createdFirehose.URN); err == nil {
+				result.firehoseDefinition = *marked
+			}
+		}
+
+		utils.WriteJSON(w, http.StatusCreated, result)
 	}
 }
 
-func handleGetFirehose(client entropyv1beta1.ResourceServiceClient) http.HandlerFunc {
+func handleGetFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		urn := mux.Vars(r)[pathParamURN]
 
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		// Ensure that the URN refers to a valid firehose resource.
-		def, err := getFirehoseResource(r.Context(), client, urn)
+		def, err := getFirehoseResource(r.Context(), client, prj, urn)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
+		setETag(w, def.UpdatedAt)
 		utils.WriteJSON(w, http.StatusOK, def)
 	}
 }
 
-func handleGetFirehoseHistory(entropyClient entropyv1beta1.ResourceServiceClient) http.HandlerFunc {
+func handleGetFirehoseHistory(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		urn := mux.Vars(r)[pathParamURN]
 
-		def, err := getFirehoseHistory(r.Context(), entropyClient, urn)
+		hq, err := parseHistoryQuery(r)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		// Ensure that the URN refers to a firehose in the project.
+		if _, err := getResource(r.Context(), client, prj, urn); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		def, err := getFirehoseHistory(r.Context(), client, urn, *hq)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -157,18 +287,35 @@ func handleGetFirehoseHistory(entropyClient entropyv1beta1.ResourceServiceClient
 	}
 }
 
-func handleUpdateFirehose(client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient) http.HandlerFunc {
+func handleUpdateFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		pathVars := mux.Vars(r)
 		urn := pathVars[pathParamURN]
 
-		prj, err := getProject(r, shieldClient)
+		dryRun, err := isDryRun(r)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		firehoseDef, err := getFirehoseResource(r.Context(), client, urn)
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		cur, err := getResource(r.Context(), client, prj, urn)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		if err := checkIfMatch(r, cur.GetUpdatedAt().AsTime()); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		firehoseDef, err := mapResourceToFirehose(cur, false)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -182,67 +329,190 @@ func handleUpdateFirehose(client entropyv1beta1.ResourceServiceClient, shieldCli
 			return
 		}
 
-		cfgStruct, err := updReq.Configs.toConfigStruct(prj)
+		result, err := applyFirehoseUpdate(r, client, svc, prj, cur, firehoseDef, updReq, dryRun)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		firehoseDef.Description = updReq.Description
+		if def, ok := result.(*firehoseDefinition); ok {
+			setETag(w, def.UpdatedAt)
+		}
+		utils.WriteJSON(w, http.StatusOK, result)
+	}
+}
 
-		rCtx := reqctx.From(r.Context())
-		labels := firehoseDef.getLabels()
-		labels.setUpdatedBy(rCtx)
-		labelMap, err := labels.toMap()
+func handlePatchFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		pathVars := mux.Vars(r)
+		urn := pathVars[pathParamURN]
+
+		dryRun, err := isDryRun(r)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		rpcReq := &entropyv1beta1.UpdateResourceRequest{
-			Urn:    urn,
-			Labels: labelMap,
-			NewSpec: &entropyv1beta1.ResourceSpec{
-				Configs: cfgStruct,
-			},
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
 		}
 
-		rpcResp, err := client.UpdateResource(r.Context(), rpcReq)
+		cur, err := getResource(r.Context(), client, prj, urn)
 		if err != nil {
-			st := status.Convert(err)
-			if st.Code() == codes.InvalidArgument {
-				utils.WriteErr(w, errors.ErrInvalid.WithCausef(st.Message()))
-			} else if st.Code() == codes.NotFound {
-				utils.WriteErr(w, errors.ErrNotFound.
-					WithMsgf(firehoseNotFound).
-					WithCausef(st.Message()))
-			} else {
-				utils.WriteErr(w, err)
-			}
+			utils.WriteErr(w, err)
 			return
 		}
 
-		firehoseDef, err = mapResourceToFirehose(rpcResp.GetResource(), false)
+		if err := checkIfMatch(r, cur.GetUpdatedAt().AsTime()); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		firehoseDef, err := mapResourceToFirehose(cur, false)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		utils.WriteJSON(w, http.StatusOK, firehoseDef)
+		patch, err := io.ReadAll(r.Body)
+		if err != nil {
+			utils.WriteErr(w, errors.ErrInvalid.
+				WithMsgf("failed to read body").
+				WithCausef(err.Error()))
+			return
+		}
+
+		curDoc, err := json.Marshal(updateRequestBody{
+			Description: firehoseDef.Description,
+			Configs:     *firehoseDef.Configs,
+		})
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		patchedDoc, err := applyPatch(r.Header.Get("Content-Type"), curDoc, patch)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		var updReq updateRequestBody
+		if err := json.Unmarshal(patchedDoc, &updReq); err != nil {
+			utils.WriteErr(w, errors.ErrInvalid.
+				WithMsgf("patched firehose is not valid").
+				WithCausef(err.Error()))
+			return
+		}
+
+		result, err := applyFirehoseUpdate(r, client, svc, prj, cur, firehoseDef, updReq, dryRun)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		if def, ok := result.(*firehoseDefinition); ok {
+			setETag(w, def.UpdatedAt)
+		}
+		utils.WriteJSON(w, http.StatusOK, result)
 	}
 }
 
-func handleDeleteFirehose(client entropyv1beta1.ResourceServiceClient) http.HandlerFunc {
+// applyFirehoseUpdate validates the requested update of the firehose and
+// applies it. Alert rules are disabled or restored like stop and start if
+// the update changes the run state. For dry-run, the would-be firehose is
+// returned instead.
+func applyFirehoseUpdate(r *http.Request, client entropyv1beta1.ResourceServiceClient, svc *alertsv1.Service, prj *shieldv1beta1.Project,
+	cur *entropyv1beta1.Resource, firehoseDef *firehoseDefinition, updReq updateRequestBody, dryRun bool,
+) (interface{}, error) {
+	if err := updReq.validate(firehoseDef.Configs); err != nil {
+		return nil, err
+	}
+
+	cfgStruct, err := updReq.Configs.mergeConfigStruct(cur.GetSpec().GetConfigs(), updReq.State, prj)
+	if err != nil {
+		return nil, err
+	}
+
+	stateChanged := updReq.State != "" && updReq.State != firehoseDef.State.State
+	isStop := updReq.State == stateStopped
+
+	firehoseDef.Description = updReq.Description
+
+	rCtx := reqctx.From(r.Context())
+	labels := firehoseDef.getLabels()
+	labels.setUpdatedBy(rCtx)
+	if stateChanged && isStop && !dryRun {
+		if err := snapshotAlerts(r.Context(), firehoseDef, svc, prj, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	labelMap, err := labels.toMap()
+	if err != nil {
+		return nil, err
+	}
+
+	if dryRun {
+		return buildDryRunResult(cur, withSpec(cur, cfgStruct, labelMap))
+	}
+
+	if err := recheckIfMatch(r.Context(), r, client, prj, cur.GetUrn()); err != nil {
+		return nil, err
+	}
+
+	rpcReq := &entropyv1beta1.UpdateResourceRequest{
+		Urn:    cur.GetUrn(),
+		Labels: labelMap,
+		NewSpec: &entropyv1beta1.ResourceSpec{
+			Configs: cfgStruct,
+		},
+	}
+
+	rpcResp, err := client.UpdateResource(r.Context(), rpcReq)
+	if err != nil {
+		st := status.Convert(err)
+		if st.Code() == codes.InvalidArgument {
+			return nil, errors.ErrInvalid.WithCausef(st.Message())
+		} else if st.Code() == codes.NotFound {
+			return nil, errors.ErrNotFound.
+				WithMsgf(firehoseNotFound).
+				WithCausef(st.Message())
+		}
+		return nil, err
+	}
+
+	if stateChanged {
+		return syncAlertsWithState(r.Context(), client, svc, prj, rpcResp.GetResource(), isStop)
+	}
+	return mapResourceToFirehose(rpcResp.GetResource(), false)
+}
+
+func handleDeleteFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		urn := mux.Vars(r)[pathParamURN]
 
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		// Ensure that the URN refers to a valid firehose resource.
-		if _, err := getFirehoseResource(r.Context(), client, urn); err != nil {
+		firehoseDef, err := getFirehoseResource(r.Context(), client, prj, urn)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		if err := checkIfMatch(r, firehoseDef.UpdatedAt); err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		_, err := client.DeleteResource(r.Context(), &entropyv1beta1.DeleteResourceRequest{Urn: urn})
+		_, err = client.DeleteResource(r.Context(), &entropyv1beta1.DeleteResourceRequest{Urn: urn})
 		if err != nil {
 			st := status.Convert(err)
 			if st.Code() == codes.NotFound {
@@ -253,21 +523,38 @@ func handleDeleteFirehose(client entropyv1beta1.ResourceServiceClient) http.Hand
 			return
 		}
 
+		// firehose is already deleted, so the failure is only warned about.
+		// rules left behind are reported by the orphaned alert policy APIs.
+		if err := disableAlertsForResource(r.Context(), firehoseDef, svc, prj); err != nil {
+			setWarning(w, fmt.Sprintf("firehose is deleted, but disabling its alerts failed: %v", err))
+		}
+
 		utils.WriteJSON(w, http.StatusNoContent, nil)
 	}
 }
 
-func handleResetFirehose(client entropyv1beta1.ResourceServiceClient) http.HandlerFunc {
+func handleResetFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		urn := mux.Vars(r)[pathParamURN]
 
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		// Ensure that the URN refers to a valid firehose resource.
-		firehoseDef, err := getFirehoseResource(r.Context(), client, urn)
+		firehoseDef, err := getFirehoseResource(r.Context(), client, prj, urn)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
+		if err := checkIfMatch(r, firehoseDef.UpdatedAt); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		var reqBody resetRequestBody
 		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
 			utils.WriteErr(w, errors.ErrInvalid.WithMsgf("invalid json body").WithCausef(err.Error()))
@@ -289,6 +576,11 @@ func handleResetFirehose(client entropyv1beta1.ResourceServiceClient) http.Handl
 			return
 		}
 
+		if err := recheckIfMatch(r.Context(), r, client, prj, urn); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		rpcReq := &entropyv1beta1.ApplyActionRequest{
 			Urn:    urn,
 			Action: actionResetOffset,
@@ -317,15 +609,39 @@ func handleResetFirehose(client entropyv1beta1.ResourceServiceClient) http.Handl
 			return
 		}
 
+		setETag(w, firehoseDef.UpdatedAt)
 		utils.WriteJSON(w, http.StatusOK, firehoseDef)
 	}
 }
 
-func handleScaleFirehose(client entropyv1beta1.ResourceServiceClient) http.HandlerFunc {
+func handleScaleFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		urn := mux.Vars(r)[pathParamURN]
 
-		firehoseDef, err := getFirehoseResource(r.Context(), client, urn)
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		dryRun, err := isDryRun(r)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		cur, err := getResource(r.Context(), client, prj, urn)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		if err := checkIfMatch(r, cur.GetUpdatedAt().AsTime()); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		firehoseDef, err := mapResourceToFirehose(cur, false)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -354,6 +670,34 @@ func handleScaleFirehose(client entropyv1beta1.ResourceServiceClient) http.Handl
 			return
 		}
 
+		if dryRun {
+			var modConf moduleConfig
+			if err := protoStructToGo(cur.GetSpec().GetConfigs(), &modConf); err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+			modConf.Firehose.Replicas = reqBody.Replicas
+
+			cfgStruct, err := toProtobufStruct(modConf)
+			if err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+
+			result, err := buildDryRunResult(cur, withSpec(cur, cfgStruct, labelMap))
+			if err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+			utils.WriteJSON(w, http.StatusOK, result)
+			return
+		}
+
+		if err := recheckIfMatch(r.Context(), r, client, prj, urn); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		rpcReq := &entropyv1beta1.ApplyActionRequest{
 			Urn:    urn,
 			Action: actionScale,
@@ -382,28 +726,34 @@ func handleScaleFirehose(client entropyv1beta1.ResourceServiceClient) http.Handl
 			return
 		}
 
+		setETag(w, firehoseDef.UpdatedAt)
 		utils.WriteJSON(w, http.StatusOK, firehoseDef)
 	}
 }
 
-func handleStartOrStop(client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient, svc *alertsv1.Service, isStop bool) http.HandlerFunc {
+func handleStartOrStop(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service, isStop bool) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		urn := mux.Vars(r)[pathParamURN]
 
-		prj, err := getProject(r, shieldClient)
+		prj, err := getProject(r, projects)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
 		// Ensure that the URN refers to a valid firehose resource.
-		firehoseDef, err := getFirehoseResource(ctx, client, urn)
+		firehoseDef, err := getFirehoseResource(ctx, client, prj, urn)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
+		if err := checkIfMatch(r, firehoseDef.UpdatedAt); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		var reqBody struct{}
 		if err := json.NewDecoder(r.Body).Decode(&reqBody); err != nil {
 			utils.WriteErr(w, errors.ErrInvalid.WithMsgf("invalid json body").WithCausef(err.Error()))
@@ -419,12 +769,24 @@ func handleStartOrStop(client entropyv1beta1.ResourceServiceClient, shieldClient
 		rCtx := reqctx.From(r.Context())
 		labels := firehoseDef.getLabels()
 		labels.setUpdatedBy(rCtx)
+		if isStop {
+			if err := snapshotAlerts(ctx, firehoseDef, svc, prj, &labels); err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+		}
+
 		labelMap, err := labels.toMap()
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
+		if err := recheckIfMatch(ctx, r, client, prj, urn); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		action := actionStart
 		if isStop {
 			action = actionStop
@@ -451,23 +813,136 @@ func handleStartOrStop(client entropyv1beta1.ResourceServiceClient, shieldClient
 			return
 		}
 
-		firehoseDef, err = mapResourceToFirehose(rpcResp.GetResource(), false)
-		if err != nil {
-			utils.WriteErr(w, err)
-			return
+		firehoseDef, err = syncAlertsWithState(ctx, client, svc, prj, rpcResp.GetResource(), isStop)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		setETag(w, firehoseDef.UpdatedAt)
+		utils.WriteJSON(w, http.StatusOK, firehoseDef)
+	}
+}
+
+// snapshotAlerts records the enabled alert rules of the firehose being
+// stopped in the labels, so that they can be enabled again on start. The
+// snapshot is retained if already stopped since the rules are disabled by
+// the previous stop.
+func snapshotAlerts(ctx context.Context, firehoseDef *firehoseDefinition, svc *alertsv1.Service, prj *shieldv1beta1.Project, labels *firehoseLabels) error {
+	if firehoseDef.State.State == stateStopped {
+		return nil
+	}
+
+	templates, err := enabledAlertTemplates(ctx, firehoseDef, svc, prj)
+	if err != nil {
+		return err
+	}
+	labels.setAlertSnapshot(templates)
+	return nil
+}
+
+// syncAlertsWithState disables the alert rules of the stopped firehose, or
+// enables the rules in the snapshot again for the started firehose. The
+// snapshot is cleared once the rules are restored. Returns the firehose as
+// of the latest update.
+func syncAlertsWithState(ctx context.Context, client entropyv1beta1.ResourceServiceClient, svc *alertsv1.Service, prj *shieldv1beta1.Project, res *entropyv1beta1.Resource, isStop bool) (*firehoseDefinition, error) {
+	firehoseDef, err := mapResourceToFirehose(res, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if isStop {
+		if err := stopAlertsForResource(ctx, firehoseDef, svc, prj); err != nil {
+			return nil, err
+		}
+		return firehoseDef, nil
+	}
+
+	labels := firehoseDef.getLabels()
+	snapshot := labels.alertSnapshot()
+	if len(snapshot) == 0 {
+		return firehoseDef, nil
+	}
+
+	if err := restoreAlertsForResource(ctx, firehoseDef, svc, prj, snapshot); err != nil {
+		return nil, err
+	}
+
+	labels.setAlertSnapshot(nil)
+	if err := updateResourceLabels(ctx, client, res, labels); err != nil {
+		return nil, err
+	}
+	return getFirehoseResource(ctx, client, prj, res.GetUrn())
+}
+
+// enabledAlertTemplates returns the templates of the enabled alert rules
+// of the firehose.
+func enabledAlertTemplates(ctx context.Context, firehoseDef *firehoseDefinition, svc *alertsv1.Service, prj *shieldv1beta1.Project) ([]string, error) {
+	name, err := getFirehoseReleaseName(firehoseDef)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := svc.GetAlertPolicy(ctx, prj.GetSlug(), name)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var templates []string
+	for _, rule := range policy.Rules {
+		if rule.Enabled {
+			templates = append(templates, rule.Template)
 		}
+	}
+	return templates, nil
+}
 
-		if isStop {
-			if err := stopAlertsForResource(ctx, firehoseDef, svc, prj); err != nil {
-				utils.WriteErr(w, err)
-				return
-			}
+// restoreAlertsForResource re-enables the alert rules of the firehose with
+// the given templates. Other rules are left untouched.
+func restoreAlertsForResource(ctx context.Context, firehoseDef *firehoseDefinition, svc *alertsv1.Service, prj *shieldv1beta1.Project, templates []string) error {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	name, err := getFirehoseReleaseName(firehoseDef)
+	if err != nil {
+		return err
+	}
+
+	policy, err := svc.GetAlertPolicy(ctx, prj.GetSlug(), name)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	for i, rule := range policy.Rules {
+		if findInArray(templates, rule.Template) {
+			policy.Rules[i].Enabled = true
 		}
+	}
 
-		utils.WriteJSON(w, http.StatusOK, firehoseDef)
+	_, err = svc.UpsertAlertPolicy(ctx, prj.GetSlug(), *policy)
+	return err
+}
+
+// disableAlertsForResource disables all the alert rules of a deleted
+// firehose. Firehoses without alert policies are ignored.
+func disableAlertsForResource(ctx context.Context, firehoseDef *firehoseDefinition, svc *alertsv1.Service, prj *shieldv1beta1.Project) error {
+	name, err := getFirehoseReleaseName(firehoseDef)
+	if err != nil {
+		// release name is not available if the firehose was never deployed.
+		return nil
 	}
+	return svc.DisableAlertPolicy(ctx, prj.GetSlug(), name)
 }
 
+// stopAlertsForResource disables all the alert rules of the firehose. Rule
+// variables are retained so that the rules can be enabled again on start.
 func stopAlertsForResource(ctx context.Context, firehoseDef *firehoseDefinition, svc *alertsv1.Service, prj *shieldv1beta1.Project) error {
 	name, err := getFirehoseReleaseName(firehoseDef)
 	if err != nil {
@@ -484,7 +959,18 @@ func stopAlertsForResource(ctx context.Context, firehoseDef *firehoseDefinition,
 	return nil
 }
 
-func getFirehoseResource(ctx context.Context, client entropyv1beta1.ResourceServiceClient, firehoseURN string) (*firehoseDefinition, error) {
+func getFirehoseResource(ctx context.Context, client entropyv1beta1.ResourceServiceClient, prj *shieldv1beta1.Project, firehoseURN string) (*firehoseDefinition, error) {
+	res, err := getResource(ctx, client, prj, firehoseURN)
+	if err != nil {
+		return nil, err
+	}
+	return mapResourceToFirehose(res, false)
+}
+
+// getResource returns the entropy resource for the firehose URN. Returns
+// ErrNotFound if the URN does not refer to a firehose resource of the
+// project.
+func getResource(ctx context.Context, client entropyv1beta1.ResourceServiceClient, prj *shieldv1beta1.Project, firehoseURN string) (*entropyv1beta1.Resource, error) {
 	resp, err := client.GetResource(ctx, &entropyv1beta1.GetResourceRequest{Urn: firehoseURN})
 	if err != nil {
 		st := status.Convert(err)
@@ -496,12 +982,18 @@ func getFirehoseResource(ctx context.Context, client entropyv1beta1.ResourceServ
 		return nil, err
 	} else if resp.GetResource().GetKind() != kindFirehose {
 		return nil, errors.ErrNotFound.WithMsgf(firehoseNotFound)
+	} else if resp.GetResource().GetProject() != prj.GetSlug() {
+		// Firehoses of other projects must be indistinguishable from
+		// the ones that do not exist.
+		return nil, errors.ErrNotFound.
+			WithMsgf(firehoseNotFound).
+			WithCausef("firehose belongs to a different project")
 	}
 
-	return mapResourceToFirehose(resp.GetResource(), false)
+	return resp.GetResource(), nil
 }
 
-func getFirehoseHistory(ctx context.Context, client entropyv1beta1.ResourceServiceClient, firehoseURN string) ([]revisionDiff, error) {
+func getFirehoseHistory(ctx context.Context, client entropyv1beta1.ResourceServiceClient, firehoseURN string, hq historyQuery) ([]revisionDiff, error) {
 	resp, err := client.GetResourceRevisions(ctx, &entropyv1beta1.GetResourceRevisionsRequest{Urn: firehoseURN})
 	if err != nil {
 		st := status.Convert(err)
@@ -513,34 +1005,134 @@ func getFirehoseHistory(ctx context.Context, client entropyv1beta1.ResourceServi
 		return nil, err
 	}
 
-	prevSpec := []byte("{}")
-	var rh []revisionDiff
-
-	for _, revision := range resp.GetRevisions() {
-		var rd revisionDiff
-		currentSpec, err := protojson.MarshalOptions{
+	marshalSpec := func(revision *entropyv1beta1.ResourceRevision) ([]byte, error) {
+		return protojson.MarshalOptions{
 			UseProtoNames: true,
 		}.Marshal(revision.GetSpec())
+	}
+
+	newRevisionDiff := func(revision *entropyv1beta1.ResourceRevision, baseID string, prevSpec, currentSpec []byte) (*revisionDiff, error) {
+		diff, err := specDiff(hq.Format, revisionName(baseID), revisionName(revision.GetId()), prevSpec, currentSpec)
+		if err != nil {
+			return nil, err
+		}
+
+		rd := &revisionDiff{
+			ID:        revision.GetId(),
+			BaseID:    baseID,
+			Diff:      diff,
+			Labels:    revision.GetLabels(),
+			UpdatedAt: revision.GetCreatedAt().AsTime(),
+		}
+		if hq.View == viewFull {
+			rd.Spec = json.RawMessage(currentSpec)
+		}
+		return rd, nil
+	}
+
+	if hq.From != "" {
+		var from, to *entropyv1beta1.ResourceRevision
+		for _, revision := range resp.GetRevisions() {
+			if revision.GetId() == hq.From {
+				from = revision
+			}
+			if revision.GetId() == hq.To {
+				to = revision
+			}
+		}
+
+		if from == nil || to == nil {
+			return nil, errors.ErrNotFound.
+				WithMsgf("revisions '%s' and '%s' must exist for the firehose", hq.From, hq.To)
+		}
+
+		fromSpec, err := marshalSpec(from)
+		if err != nil {
+			return nil, err
+		}
+
+		toSpec, err := marshalSpec(to)
+		if err != nil {
+			return nil, err
+		}
+
+		rd, err := newRevisionDiff(to, from.GetId(), fromSpec, toSpec)
 		if err != nil {
 			return nil, err
 		}
+		return []revisionDiff{*rd}, nil
+	}
+
+	var prevID string
+	prevSpec := []byte("{}")
+	rh := []revisionDiff{}
 
-		specDiff, err := jsonDiff(prevSpec, currentSpec)
+	for _, revision := range resp.GetRevisions() {
+		currentSpec, err := marshalSpec(revision)
 		if err != nil {
 			return nil, err
 		}
 
-		rd.Labels = revision.GetLabels()
-		rd.Diff = json.RawMessage(specDiff)
-		rd.UpdatedAt = revision.GetCreatedAt().AsTime()
-		rh = append(rh, rd)
+		// diffs are always against the predecessor, even if the
+		// predecessor itself is filtered out.
+		if hq.matches(revision.GetLabels(), revision.GetCreatedAt().AsTime()) {
+			rd, err := newRevisionDiff(revision, prevID, prevSpec, currentSpec)
+			if err != nil {
+				return nil, err
+			}
+			rh = append(rh, *rd)
+		}
+
+		prevID = revision.GetId()
 		prevSpec = currentSpec
 	}
 
 	return rh, nil
 }
 
-func handleGetFirehoseLogs(client entropyv1beta1.ResourceServiceClient) http.HandlerFunc {
+// rollbackConfigStruct returns the configs of the revision merged into the
+// current configs. Run state, stop time and chart version of the current
+// configs are retained, since those are changed only by stop/start and
+// upgrade.
+func rollbackConfigStruct(cur *entropyv1beta1.Resource, revision *entropyv1beta1.ResourceRevision, prj *shieldv1beta1.Project) (*structpb.Value, error) {
+	revDef, err := mapResourceToFirehose(&entropyv1beta1.Resource{
+		Urn:  cur.GetUrn(),
+		Spec: &entropyv1beta1.ResourceSpec{Configs: revision.GetSpec().GetConfigs()},
+	}, false)
+	if err != nil {
+		return nil, err
+	}
+
+	revConfigs := *revDef.Configs
+	revConfigs.Version = ""
+	revConfigs.StopDate = nil
+	return revConfigs.mergeConfigStruct(cur.GetSpec().GetConfigs(), "", prj)
+}
+
+// getRevision returns the revision of the firehose with the given ID.
+func getRevision(ctx context.Context, client entropyv1beta1.ResourceServiceClient, firehoseURN, revisionID string) (*entropyv1beta1.ResourceRevision, error) {
+	resp, err := client.GetResourceRevisions(ctx, &entropyv1beta1.GetResourceRevisionsRequest{Urn: firehoseURN})
+	if err != nil {
+		st := status.Convert(err)
+		if st.Code() == codes.NotFound {
+			return nil, errors.ErrNotFound.
+				WithMsgf(firehoseNotFound).
+				WithCausef(st.Message())
+		}
+		return nil, err
+	}
+
+	for _, revision := range resp.GetRevisions() {
+		if revision.GetId() == revisionID {
+			return revision, nil
+		}
+	}
+
+	return nil, errors.ErrNotFound.
+		WithMsgf("revision '%s' not found for the firehose", revisionID)
+}
+
+func handleGetFirehoseLogs(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		flusher, ok := w.(http.Flusher)
 		if !ok {
@@ -549,6 +1141,19 @@ func handleGetFirehoseLogs(client entropyv1beta1.ResourceServiceClient) http.Han
 		}
 
 		urn := mux.Vars(r)[pathParamURN]
+
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		// Ensure that the URN refers to a firehose in the project.
+		if _, err := getResource(r.Context(), client, prj, urn); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		queryParams := r.URL.Query()
 
 		filters := map[string]string{}
@@ -604,21 +1209,38 @@ func handleGetFirehoseLogs(client entropyv1beta1.ResourceServiceClient) http.Han
 	}
 }
 
-func handleUpgradeFirehose(client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient,
+func handleUpgradeFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver,
 	latestFirehoseVersion string,
 ) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		pathVars := mux.Vars(r)
 		urn := pathVars[pathParamURN]
 
-		prj, err := getProject(r, shieldClient)
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		dryRun, err := isDryRun(r)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
 		// Ensure that the URN refers to a valid firehose resource.
-		cur, err := getFirehoseResource(r.Context(), client, urn)
+		curRes, err := getResource(r.Context(), client, prj, urn)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		if err := checkIfMatch(r, curRes.GetUpdatedAt().AsTime()); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		cur, err := mapResourceToFirehose(curRes, false)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -628,7 +1250,7 @@ func handleUpgradeFirehose(client entropyv1beta1.ResourceServiceClient, shieldCl
 		}
 
 		cur.Configs.Version = latestFirehoseVersion
-		cfgStruct, err := cur.Configs.toConfigStruct(prj)
+		cfgStruct, err := cur.Configs.mergeConfigStruct(curRes.GetSpec().GetConfigs(), "", prj)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -643,6 +1265,21 @@ func handleUpgradeFirehose(client entropyv1beta1.ResourceServiceClient, shieldCl
 			return
 		}
 
+		if dryRun {
+			result, err := buildDryRunResult(curRes, withSpec(curRes, cfgStruct, labelMap))
+			if err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+			utils.WriteJSON(w, http.StatusOK, result)
+			return
+		}
+
+		if err := recheckIfMatch(r.Context(), r, client, prj, urn); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
 		rpcReq := &entropyv1beta1.UpdateResourceRequest{
 			Urn:    urn,
 			Labels: labelMap,
@@ -672,58 +1309,130 @@ func handleUpgradeFirehose(client entropyv1beta1.ResourceServiceClient, shieldCl
 			return
 		}
 
+		setETag(w, firehoseDef.UpdatedAt)
 		utils.WriteJSON(w, http.StatusOK, firehoseDef)
 	}
 }
 
-func handleGetFirehoseAlertPolicies(client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient, svc *alertsv1.Service) http.HandlerFunc {
+func handleRollbackFirehose(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		ctx := r.Context()
 		pathVars := mux.Vars(r)
 		urn := pathVars[pathParamURN]
+		revisionID := pathVars[pathParamRevision]
 
-		prj, err := getProject(r, shieldClient)
+		prj, err := getProject(r, projects)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		firehoseDef, err := getFirehoseResource(r.Context(), client, urn)
+		dryRun, err := isDryRun(r)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		name, err := getFirehoseReleaseName(firehoseDef)
+		cur, err := getResource(r.Context(), client, prj, urn)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		policyDef, err := svc.GetAlertPolicy(ctx, prj.GetSlug(), name)
+		if err := checkIfMatch(r, cur.GetUpdatedAt().AsTime()); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		revision, err := getRevision(r.Context(), client, urn, revisionID)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		policyDef.Rules = removeSuppliedVariablesFromRules(policyDef.Rules, suppliedAlertVariableNames)
-		utils.WriteJSON(w, http.StatusOK, policyDef)
+		firehoseDef, err := mapResourceToFirehose(cur, true)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		rCtx := reqctx.From(r.Context())
+		labels := firehoseDef.getLabels()
+		labels.setUpdatedBy(rCtx)
+		labelMap, err := labels.toMap()
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+		labelMap[labelRolledBackTo] = revision.GetId()
+
+		cfgStruct, err := rollbackConfigStruct(cur, revision, prj)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		if dryRun {
+			result, err := buildDryRunResult(cur, withSpec(cur, cfgStruct, labelMap))
+			if err != nil {
+				utils.WriteErr(w, err)
+				return
+			}
+			utils.WriteJSON(w, http.StatusOK, result)
+			return
+		}
+
+		if err := recheckIfMatch(r.Context(), r, client, prj, urn); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		rpcReq := &entropyv1beta1.UpdateResourceRequest{
+			Urn:    urn,
+			Labels: labelMap,
+			NewSpec: &entropyv1beta1.ResourceSpec{
+				Configs: cfgStruct,
+			},
+		}
+
+		rpcResp, err := client.UpdateResource(r.Context(), rpcReq)
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.InvalidArgument {
+				utils.WriteErr(w, errors.ErrInvalid.WithCausef(st.Message()))
+			} else if st.Code() == codes.NotFound {
+				utils.WriteErr(w, errors.ErrNotFound.
+					WithMsgf(firehoseNotFound).
+					WithCausef(st.Message()))
+			} else {
+				utils.WriteErr(w, err)
+			}
+			return
+		}
+
+		firehoseDef, err = mapResourceToFirehose(rpcResp.GetResource(), false)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		setETag(w, firehoseDef.UpdatedAt)
+		utils.WriteJSON(w, http.StatusOK, firehoseDef)
 	}
 }
 
-func handleUpsertFirehoseAlertPolicies(client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient, svc *alertsv1.Service) http.HandlerFunc {
+func handleGetFirehoseAlertPolicies(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		pathVars := mux.Vars(r)
 		urn := pathVars[pathParamURN]
 
-		prj, err := getProject(r, shieldClient)
+		prj, err := getProject(r, projects)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		firehoseDef, err := getFirehoseResource(r.Context(), client, urn)
+		firehoseDef, err := getFirehoseResource(r.Context(), client, prj, urn)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -734,8 +1443,23 @@ func handleUpsertFirehoseAlertPolicies(client entropyv1beta1.ResourceServiceClie
 			utils.WriteErr(w, err)
 			return
 		}
-		group := firehoseDef.Group
-		entity, err := svc.GetProjectDataSource(ctx, prj.GetSlug())
+
+		policyDef, err := svc.GetAlertPolicy(ctx, prj.GetSlug(), name)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		policyDef.Rules = removeSuppliedVariablesFromRules(policyDef.Rules, suppliedAlertVariableNames)
+		utils.WriteJSON(w, http.StatusOK, policyDef)
+	}
+}
+
+func handleUpsertFirehoseAlertPolicies(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		target, err := getAlertTarget(r, client, projects)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -749,14 +1473,7 @@ func handleUpsertFirehoseAlertPolicies(client entropyv1beta1.ResourceServiceClie
 			return
 		}
 
-		policyDef.Rules = addSuppliedVariablesFromRules(policyDef.Rules, map[string]string{
-			"team":   group,
-			"name":   name,
-			"entity": entity,
-		})
-		policyDef.Resource = name
-
-		alertPolicy, err := svc.UpsertAlertPolicy(ctx, prj.GetSlug(), policyDef)
+		alertPolicy, err := applyAlertPolicy(ctx, svc, target, policyDef)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
@@ -766,72 +1483,55 @@ func handleUpsertFirehoseAlertPolicies(client entropyv1beta1.ResourceServiceClie
 	}
 }
 
-func handleListFirehoseAlerts(client entropyv1beta1.ResourceServiceClient, shieldClient shieldv1beta1.ShieldServiceClient, svc *alertsv1.Service) http.HandlerFunc {
+func handleListFirehoseAlerts(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		ctx := r.Context()
 		pathVars := mux.Vars(r)
 		urn := pathVars[pathParamURN]
 
-		prj, err := getProject(r, shieldClient)
+		prj, err := getProject(r, projects)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		firehoseDef, err := getFirehoseResource(r.Context(), client, urn)
+		query, err := parseAlertQuery(r)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		name, err := getFirehoseReleaseName(firehoseDef)
+		firehoseDef, err := getFirehoseResource(r.Context(), client, prj, urn)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		alerts, err := svc.ListAlerts(ctx, prj.GetSlug(), name)
+		name, err := getFirehoseReleaseName(firehoseDef)
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
 		}
 
-		resp := listResponse[alertsv1.Alert]{Items: alerts}
-		utils.WriteJSON(w, http.StatusOK, resp)
-	}
-}
-
-func getProject(r *http.Request, shieldClient shieldv1beta1.ShieldServiceClient) (*shieldv1beta1.Project, error) {
-	projectID := strings.TrimSpace(r.Header.Get(headerProjectID))
-	projectSlug := mux.Vars(r)[pathParamProjectSlug]
-
-	if projectID == "" {
-		// List everything and search by slug.
-		projects, err := shieldClient.ListProjects(r.Context(), &shieldv1beta1.ListProjectsRequest{})
+		alerts, err := svc.ListAlerts(ctx, prj.GetSlug(), name, query.Filter)
 		if err != nil {
-			return nil, err
-		}
-		for _, prj := range projects.GetProjects() {
-			if prj.GetSlug() == projectSlug {
-				return prj, nil
-			}
+			utils.WriteErr(w, err)
+			return
 		}
-		return nil, errors.ErrNotFound
-	}
 
-	// Project ID is available. Use it to fetch the project directly.
-	prj, err := shieldClient.GetProject(r.Context(), &shieldv1beta1.GetProjectRequest{Id: projectID})
-	if err != nil {
-		st := status.Convert(err)
-		if st.Code() == codes.NotFound {
-			return nil, errors.ErrNotFound
+		items := make([]firehoseAlert, len(alerts))
+		for i, alert := range alerts {
+			items[i] = firehoseAlert{Alert: alert, URN: firehoseDef.URN}
 		}
-		return nil, err
-	} else if prj.GetProject().Slug != projectSlug {
-		return nil, errors.ErrNotFound.WithCausef("projectSlug in URL does not match project of given ID")
+
+		items, nextPageToken := paginateAlerts(items, query.Page)
+		resp := listResponse[firehoseAlert]{Items: items, NextPageToken: nextPageToken}
+		utils.WriteJSON(w, http.StatusOK, resp)
 	}
+}
 
-	return prj.GetProject(), nil
+func getProject(r *http.Request, projects *projectsv1.Resolver) (*shieldv1beta1.Project, error) {
+	return projects.Resolve(r, mux.Vars(r)[pathParamProjectSlug])
 }
 
 func getFirehoseReleaseName(firehoseDef *firehoseDefinition) (string, error) {
@@ -842,6 +1542,20 @@ func getFirehoseReleaseName(firehoseDef *firehoseDefinition) (string, error) {
 	return s, nil
 }
 
+// setWarning sets a miscellaneous warning (code 199) on the response, for
+// failures that do not fail the request.
+func setWarning(w http.ResponseWriter, msg string) {
+	w.Header().Add(headerWarning, "199 dex "+strconv.Quote(msg))
+}
+
+// getResourceReleaseName returns the release name from the state output
+// of the firehose resource, without mapping the rest of the resource.
+// Returns false if the firehose was never deployed.
+func getResourceReleaseName(res *entropyv1beta1.Resource) (string, bool) {
+	name := res.GetState().GetOutput().GetStructValue().GetFields()[firehoseOutputReleaseNameKey].GetStringValue()
+	return name, name != ""
+}
+
 func findInArray(a []string, f string) bool {
 	for _, s := range a {
 		if s == f {
@@ -897,3 +1611,37 @@ func jsonDiff(left, right []byte) (string, error) {
 
 	return diffString, nil
 }
+
+// handleListSinkTypes lists the sink definitions, which are maintained only
+// for the latest firehose version.
+func handleListSinkTypes(latestFirehoseVersion string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var arr []sinkTypeSummary
+		for _, def := range sinkDefinitions {
+			arr = append(arr, sinkTypeSummary{
+				Type:         def.Type,
+				Version:      latestFirehoseVersion,
+				EnvVarPrefix: def.Prefix,
+			})
+		}
+
+		resp := listResponse[sinkTypeSummary]{Items: arr}
+		utils.WriteJSON(w, http.StatusOK, resp)
+	}
+}
+
+func handleGetSinkTypeSchema(latestFirehoseVersion string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		sinkType := mux.Vars(r)[pathParamSinkType]
+
+		def, found := getSinkDefinition(sinkType)
+		if !found {
+			utils.WriteErr(w, errors.ErrNotFound.
+				WithMsgf("sink type must be one of %s", strings.Join(sinkTypeNames(), ", ")).
+				WithCausef("unknown sink type '%s'", sinkType))
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusOK, def.schema(latestFirehoseVersion))
+	}
+}
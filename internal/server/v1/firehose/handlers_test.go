@@ -0,0 +1,124 @@
+package firehose
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type fakeResourceClient struct {
+	entropyv1beta1.ResourceServiceClient
+	resources map[string]*entropyv1beta1.Resource
+}
+
+func (f *fakeResourceClient) GetResource(_ context.Context, in *entropyv1beta1.GetResourceRequest, _ ...grpc.CallOption) (*entropyv1beta1.GetResourceResponse, error) {
+	res, found := f.resources[in.GetUrn()]
+	if !found {
+		return nil, errors.ErrNotFound
+	}
+	return &entropyv1beta1.GetResourceResponse{Resource: res}, nil
+}
+
+func Test_getResource(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeResourceClient{
+		resources: map[string]*entropyv1beta1.Resource{
+			"orn:foo:firehose:a:f1": {Urn: "orn:foo:firehose:a:f1", Kind: kindFirehose, Project: "a"},
+			"orn:foo:kafka:a:k1":    {Urn: "orn:foo:kafka:a:k1", Kind: "kafka", Project: "a"},
+		},
+	}
+	prjA := &shieldv1beta1.Project{Slug: "a"}
+	prjB := &shieldv1beta1.Project{Slug: "b"}
+
+	res, err := getResource(context.Background(), client, prjA, "orn:foo:firehose:a:f1")
+	require.NoError(t, err)
+	assert.Equal(t, "orn:foo:firehose:a:f1", res.GetUrn())
+
+	_, err = getResource(context.Background(), client, prjB, "orn:foo:firehose:a:f1")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	_, err = getResource(context.Background(), client, prjA, "orn:foo:kafka:a:k1")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+}
+
+type fakeRuleSirenClient struct {
+	fakeSirenClient
+}
+
+func (f *fakeRuleSirenClient) UpdateRule(_ context.Context, in *sirenv1beta1.UpdateRuleRequest, _ ...grpc.CallOption) (*sirenv1beta1.UpdateRuleResponse, error) {
+	for _, r := range f.rules {
+		if r.Namespace == in.GetNamespace() && r.Template == in.GetTemplate() {
+			r.Enabled = in.GetEnabled()
+			return &sirenv1beta1.UpdateRuleResponse{Rule: r}, nil
+		}
+	}
+	return nil, errors.ErrNotFound
+}
+
+func Test_syncAlertsWithState(t *testing.T) {
+	t.Parallel()
+
+	configs, err := toProtobufStruct(moduleConfig{State: stateRunning})
+	require.NoError(t, err)
+	output, err := structpb.NewValue(map[string]interface{}{firehoseOutputReleaseNameKey: "fh-1-firehose"})
+	require.NoError(t, err)
+
+	res := &entropyv1beta1.Resource{
+		Urn:     "fh-1",
+		Kind:    kindFirehose,
+		Project: "a",
+		Labels:  map[string]string{"title": "fh-1", "alert_snapshot": "lag"},
+		Spec:    &entropyv1beta1.ResourceSpec{Configs: configs},
+		State:   &entropyv1beta1.ResourceState{Output: output},
+	}
+	client := &fakeLabelsClient{
+		fakeResourceClient: fakeResourceClient{
+			resources: map[string]*entropyv1beta1.Resource{"fh-1": res},
+		},
+	}
+	siren := &fakeRuleSirenClient{fakeSirenClient: fakeSirenClient{
+		namespaces: []*sirenv1beta1.Namespace{
+			{Id: 1, Name: "ns-1", Labels: map[string]string{"projects": "a"}},
+		},
+		rules: []*sirenv1beta1.Rule{
+			{Namespace: "fh-1-firehose", Template: "lag", Enabled: false, ProviderNamespace: 1},
+		},
+	}}
+	svc := alertsv1.NewService(siren, alertsv1.NamespaceCacheConfig{})
+	prj := &shieldv1beta1.Project{Slug: "a"}
+
+	_, err = syncAlertsWithState(context.Background(), client, svc, prj, res, false)
+	require.NoError(t, err)
+	assert.True(t, siren.rules[0].Enabled)
+
+	// snapshot is cleared once restored.
+	require.Len(t, client.updates, 1)
+	assert.NotContains(t, client.updates[0].Labels, "alert_snapshot")
+	assert.Equal(t, "fh-1", client.updates[0].Labels["title"])
+
+	// nothing to restore without a snapshot.
+	res.Labels = client.updates[0].Labels
+	_, err = syncAlertsWithState(context.Background(), client, svc, prj, res, false)
+	require.NoError(t, err)
+	assert.Len(t, client.updates, 1)
+}
+
+func Test_setWarning(t *testing.T) {
+	t.Parallel()
+
+	w := httptest.NewRecorder()
+	setWarning(w, `disabling alerts failed: rule "lag" not found`)
+	assert.Equal(t, `199 dex "disabling alerts failed: rule \"lag\" not found"`, w.Header().Get(headerWarning))
+}
@@ -0,0 +1,250 @@
+package firehose
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	diffFormatDelta     = "delta"
+	diffFormatJSONPatch = "json_patch"
+	diffFormatUnified   = "unified"
+
+	labelUpdatedBy      = "updated_by"
+	labelUpdatedByEmail = "updated_by_email"
+)
+
+type historyQuery struct {
+	Format string
+	View   string
+
+	// From & To select a pair of revisions to be compared. When
+	// set, only the 'To' revision is returned with diff against
+	// 'From' revision instead of its predecessor.
+	From string
+	To   string
+
+	UpdatedBy string
+	Since     *time.Time
+	Until     *time.Time
+}
+
+type jsonPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	From  string          `json:"from,omitempty"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+func parseHistoryQuery(r *http.Request) (*historyQuery, error) {
+	q := r.URL.Query()
+
+	hq := &historyQuery{
+		Format:    diffFormatDelta,
+		View:      viewBasic,
+		From:      strings.TrimSpace(q.Get("from")),
+		To:        strings.TrimSpace(q.Get("to")),
+		UpdatedBy: strings.TrimSpace(q.Get("updated_by")),
+	}
+
+	if format := strings.ToLower(strings.TrimSpace(q.Get("format"))); format != "" {
+		if format != diffFormatDelta && format != diffFormatJSONPatch && format != diffFormatUnified {
+			return nil, errors.ErrInvalid.
+				WithMsgf("format must be one of %s, %s, %s", diffFormatDelta, diffFormatJSONPatch, diffFormatUnified).
+				WithCausef("invalid format '%s'", format)
+		}
+		hq.Format = format
+	}
+
+	if view := strings.ToLower(strings.TrimSpace(q.Get("view"))); view != "" {
+		if view != viewBasic && view != viewFull {
+			return nil, errors.ErrInvalid.
+				WithMsgf("view must be one of %s, %s", viewBasic, viewFull).
+				WithCausef("invalid view '%s'", view)
+		}
+		hq.View = view
+	}
+
+	if (hq.From == "") != (hq.To == "") {
+		return nil, errors.ErrInvalid.WithMsgf("from and to must be specified together")
+	}
+
+	for param, dst := range map[string]**time.Time{"since": &hq.Since, "until": &hq.Until} {
+		s := strings.TrimSpace(q.Get(param))
+		if s == "" {
+			continue
+		}
+
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return nil, errors.ErrInvalid.
+				WithMsgf("%s must be a RFC3339 timestamp", param).
+				WithCausef(err.Error())
+		}
+		*dst = &t
+	}
+
+	return hq, nil
+}
+
+// matches returns true if the revision with given labels and creation
+// time satisfies the author and time-range filters.
+func (hq historyQuery) matches(labels map[string]string, createdAt time.Time) bool {
+	if hq.UpdatedBy != "" && labels[labelUpdatedBy] != hq.UpdatedBy && labels[labelUpdatedByEmail] != hq.UpdatedBy {
+		return false
+	} else if hq.Since != nil && createdAt.Before(*hq.Since) {
+		return false
+	} else if hq.Until != nil && createdAt.After(*hq.Until) {
+		return false
+	}
+	return true
+}
+
+// specDiff returns the diff between the specs in the requested format.
+// Delta and JSON-patch formats are JSON documents, while the unified
+// format is returned as a JSON string.
+func specDiff(format, fromName, toName string, left, right []byte) (json.RawMessage, error) {
+	switch format {
+	case diffFormatJSONPatch:
+		ops, err := jsonPatch(left, right)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(ops)
+
+	case diffFormatUnified:
+		diff, err := unifiedDiff(fromName, toName, left, right)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(diff)
+
+	default:
+		diff, err := jsonDiff(left, right)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(diff), nil
+	}
+}
+
+// jsonPatch returns RFC 6902 operations to transform left into right.
+// Arrays are replaced as a whole when they differ.
+func jsonPatch(left, right []byte) ([]jsonPatchOp, error) {
+	var l, r interface{}
+	if err := json.Unmarshal(left, &l); err != nil {
+		return nil, err
+	} else if err := json.Unmarshal(right, &r); err != nil {
+		return nil, err
+	}
+
+	return appendPatchOps([]jsonPatchOp{}, "", l, r)
+}
+
+func appendPatchOps(ops []jsonPatchOp, path string, l, r interface{}) ([]jsonPatchOp, error) {
+	lm, isLeftMap := l.(map[string]interface{})
+	rm, isRightMap := r.(map[string]interface{})
+	if !isLeftMap || !isRightMap {
+		if reflect.DeepEqual(l, r) {
+			return ops, nil
+		}
+
+		val, err := json.Marshal(r)
+		if err != nil {
+			return nil, err
+		}
+		return append(ops, jsonPatchOp{Op: "replace", Path: path, Value: val}), nil
+	}
+
+	keySet := map[string]struct{}{}
+	for k := range lm {
+		keySet[k] = struct{}{}
+	}
+	for k := range rm {
+		keySet[k] = struct{}{}
+	}
+
+	keys := make([]string, 0, len(keySet))
+	for k := range keySet {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pointerEscaper := strings.NewReplacer("~", "~0", "/", "~1")
+	for _, k := range keys {
+		p := path + "/" + pointerEscaper.Replace(k)
+		lv, inLeft := lm[k]
+		rv, inRight := rm[k]
+
+		var err error
+		switch {
+		case !inRight:
+			ops = append(ops, jsonPatchOp{Op: "remove", Path: p})
+
+		case !inLeft:
+			val, marshalErr := json.Marshal(rv)
+			if marshalErr != nil {
+				return nil, marshalErr
+			}
+			ops = append(ops, jsonPatchOp{Op: "add", Path: p, Value: val})
+
+		default:
+			ops, err = appendPatchOps(ops, p, lv, rv)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return ops, nil
+}
+
+func unifiedDiff(fromName, toName string, left, right []byte) (string, error) {
+	toLines := func(b []byte) ([]string, error) {
+		var v interface{}
+		if err := json.Unmarshal(b, &v); err != nil {
+			return nil, err
+		}
+
+		// re-marshal to get stable key order & indentation.
+		indented, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		return difflib.SplitLines(string(indented) + "\n"), nil
+	}
+
+	a, err := toLines(left)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := toLines(right)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        a,
+		B:        b,
+		FromFile: fromName,
+		ToFile:   toName,
+		Context:  3,
+	})
+}
+
+func revisionName(id string) string {
+	if id == "" {
+		return "/dev/null"
+	}
+	return fmt.Sprintf("revision/%s", id)
+}
@@ -0,0 +1,100 @@
+package firehose
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func Test_specDiff(t *testing.T) {
+	t.Parallel()
+
+	left := []byte(`{"configs": {"replicas": 1, "env": {"A": "1", "B/C": "2"}}}`)
+	right := []byte(`{"configs": {"replicas": 2, "env": {"A": "1", "D": "3"}}}`)
+
+	t.Run("JSONPatch", func(t *testing.T) {
+		diff, err := specDiff(diffFormatJSONPatch, "", "", left, right)
+		require.NoError(t, err)
+
+		var ops []map[string]interface{}
+		require.NoError(t, json.Unmarshal(diff, &ops))
+		assert.Equal(t, []map[string]interface{}{
+			{"op": "remove", "path": "/configs/env/B~1C"},
+			{"op": "add", "path": "/configs/env/D", "value": "3"},
+			{"op": "replace", "path": "/configs/replicas", "value": float64(2)},
+		}, ops)
+	})
+
+	t.Run("Unified", func(t *testing.T) {
+		diff, err := specDiff(diffFormatUnified, revisionName("1"), revisionName("2"), left, right)
+		require.NoError(t, err)
+
+		var text string
+		require.NoError(t, json.Unmarshal(diff, &text))
+		assert.Contains(t, text, "--- revision/1\n+++ revision/2\n")
+		assert.Contains(t, text, "-    \"replicas\": 1\n+    \"replicas\": 2\n")
+	})
+}
+
+func Test_parseHistoryQuery(t *testing.T) {
+	t.Parallel()
+
+	hq, err := parseHistoryQuery(httptest.NewRequest("GET", "/history?format=json_patch&from=1&to=3&since=2022-01-01T00:00:00Z", nil))
+	require.NoError(t, err)
+	assert.Equal(t, diffFormatJSONPatch, hq.Format)
+	assert.Equal(t, "3", hq.To)
+	require.NotNil(t, hq.Since)
+
+	for _, query := range []string{"format=xml", "view=compact", "from=1", "until=yesterday"} {
+		_, err := parseHistoryQuery(httptest.NewRequest("GET", "/history?"+query, nil))
+		assert.ErrorIs(t, err, errors.ErrInvalid, query)
+	}
+}
+
+func Test_rollbackConfigStruct(t *testing.T) {
+	t.Parallel()
+
+	stopTime := time.Date(2022, 6, 23, 0, 0, 0, 0, time.UTC)
+	curConfigs, err := toProtobufStruct(moduleConfig{
+		State:        stateStopped,
+		ChartVersion: "0.1.3",
+		StopTime:     &stopTime,
+		Firehose:     moduleConfigFirehoseDef{Replicas: 2, KafkaTopic: "booking-log"},
+	})
+	require.NoError(t, err)
+
+	revConfigs, err := toProtobufStruct(moduleConfig{
+		State:        stateRunning,
+		ChartVersion: "0.1.1",
+		Firehose:     moduleConfigFirehoseDef{Replicas: 1, KafkaTopic: "booking-log"},
+	})
+	require.NoError(t, err)
+
+	cur := &entropyv1beta1.Resource{
+		Urn:  "orn:entropy:firehose:a:fh-1",
+		Kind: kindFirehose,
+		Spec: &entropyv1beta1.ResourceSpec{Configs: curConfigs},
+	}
+	revision := &entropyv1beta1.ResourceRevision{
+		Spec: &entropyv1beta1.ResourceSpec{Configs: revConfigs},
+	}
+
+	cfgStruct, err := rollbackConfigStruct(cur, revision, &shieldv1beta1.Project{Slug: "a"})
+	require.NoError(t, err)
+
+	var got moduleConfig
+	require.NoError(t, protoStructToGo(cfgStruct, &got))
+	assert.Equal(t, stateStopped, got.State)
+	assert.Equal(t, "0.1.3", got.ChartVersion)
+	require.NotNil(t, got.StopTime)
+	assert.True(t, stopTime.Equal(*got.StopTime))
+	assert.Equal(t, 1, got.Firehose.Replicas)
+}
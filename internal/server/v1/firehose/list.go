@@ -0,0 +1,356 @@
+package firehose
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	stateRunning = "RUNNING"
+	stateStopped = "STOPPED"
+
+	viewBasic = "basic"
+	viewFull  = "full"
+
+	sortByName      = "name"
+	sortByCreatedAt = "created_at"
+	sortByUpdatedAt = "updated_at"
+
+	maxPageSize = 500
+
+	// cursorTimeLayout formats the timestamps in page cursors with a fixed
+	// width, so that they sort the same as the timestamps.
+	cursorTimeLayout = "2006-01-02T15:04:05.000000000Z"
+)
+
+// pagingParams are the query parameters that do not affect the selection or
+// the order of the listed items, and hence are not bound to page tokens.
+var pagingParams = []string{"page_token", "page_size", "view", "fields"}
+
+// projectableFields are the top-level JSON fields of firehoseDefinition
+// that can be selected using the 'fields' query parameter.
+var projectableFields = []string{
+	"urn", "name", "group", "title", "created_at", "updated_at",
+	"description", "kube_cluster", "configs", "state",
+}
+
+type listFilter struct {
+	Group       string
+	KubeCluster string
+	Status      string
+	TopicName   string
+	StreamName  string
+	SinkType    string
+}
+
+// pageParams selects a page using the page_size and page_token query
+// parameters.
+type pageParams struct {
+	Size  int
+	After *pageCursor // nil selects the first page.
+
+	// query is the hash of the sort & filter params the page tokens are
+	// bound to.
+	query string
+}
+
+// pageCursor is the position of the last item of a page in the listing
+// order: its sort key, and its unique name that breaks the ties.
+type pageCursor struct {
+	Key  string `json:"key"`
+	Name string `json:"name"`
+}
+
+type pageToken struct {
+	pageCursor
+	Query string `json:"query"`
+}
+
+type listPage struct {
+	pageParams
+	SortBy     string
+	Descending bool
+	View       string
+	Fields     []string
+}
+
+func parseListFilter(r *http.Request) (*listFilter, error) {
+	q := r.URL.Query()
+
+	filter := &listFilter{
+		Group:       strings.TrimSpace(q.Get("group")),
+		KubeCluster: strings.TrimSpace(q.Get("kube_cluster")),
+		Status:      strings.ToUpper(strings.TrimSpace(q.Get("status"))),
+		TopicName:   strings.TrimSpace(q.Get("topic_name")),
+		StreamName:  strings.TrimSpace(q.Get("stream_name")),
+		SinkType:    strings.ToUpper(strings.TrimSpace(q.Get("sink_type"))),
+	}
+
+	if filter.Status != "" && filter.Status != stateRunning && filter.Status != stateStopped {
+		return nil, errors.ErrInvalid.
+			WithMsgf("status must be one of %s, %s", stateRunning, stateStopped).
+			WithCausef("invalid status filter '%s'", filter.Status)
+	}
+
+	return filter, nil
+}
+
+func (lf listFilter) matches(def firehoseDefinition) bool {
+	if lf.Group != "" && def.Group != lf.Group {
+		return false
+	} else if lf.KubeCluster != "" && def.KubeCluster != lf.KubeCluster {
+		return false
+	}
+
+	if lf.Status != "" && (def.State == nil || !strings.EqualFold(def.State.State, lf.Status)) {
+		return false
+	}
+
+	cfg := def.Configs
+	if cfg == nil {
+		return lf.TopicName == "" && lf.StreamName == "" && lf.SinkType == ""
+	}
+
+	switch {
+	case lf.TopicName != "" && cfg.TopicName != lf.TopicName:
+		return false
+	case lf.StreamName != "" && cfg.StreamName != lf.StreamName:
+		return false
+	case lf.SinkType != "" && !strings.EqualFold(cfg.SinkType, lf.SinkType):
+		return false
+	}
+	return true
+}
+
+func parseListPage(r *http.Request) (*listPage, error) {
+	q := r.URL.Query()
+
+	page := &listPage{
+		SortBy: sortByName,
+		View:   viewBasic,
+	}
+
+	var err error
+	page.pageParams, err = parsePageParams(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if sortBy := strings.TrimSpace(q.Get("sort_by")); sortBy != "" {
+		page.Descending = strings.HasPrefix(sortBy, "-")
+		page.SortBy = strings.TrimPrefix(sortBy, "-")
+		if page.SortBy != sortByName && page.SortBy != sortByCreatedAt && page.SortBy != sortByUpdatedAt {
+			return nil, errors.ErrInvalid.
+				WithMsgf("sort_by must be one of %s, %s, %s", sortByName, sortByCreatedAt, sortByUpdatedAt).
+				WithCausef("invalid sort_by '%s'", sortBy)
+		}
+	}
+
+	if view := strings.ToLower(strings.TrimSpace(q.Get("view"))); view != "" {
+		if view != viewBasic && view != viewFull {
+			return nil, errors.ErrInvalid.
+				WithMsgf("view must be one of %s, %s", viewBasic, viewFull).
+				WithCausef("invalid view '%s'", view)
+		}
+		page.View = view
+	}
+
+	if fields := strings.TrimSpace(q.Get("fields")); fields != "" {
+		for _, field := range strings.Split(fields, ",") {
+			field = strings.TrimSpace(field)
+			if field == "" {
+				continue
+			} else if !findInArray(projectableFields, field) {
+				return nil, errors.ErrInvalid.
+					WithMsgf("fields must be a subset of %s", strings.Join(projectableFields, ", ")).
+					WithCausef("unknown field '%s'", field)
+			}
+			page.Fields = append(page.Fields, field)
+		}
+	}
+
+	return page, nil
+}
+
+// parsePageParams returns the page selected by the page_token and page_size
+// query parameters. Page tokens issued for a different sort or filter are
+// rejected, since the cursor in them is meaningless for the current query.
+func parsePageParams(r *http.Request) (pageParams, error) {
+	q := r.URL.Query()
+	page := pageParams{query: hashQuery(q)}
+
+	if s := strings.TrimSpace(q.Get("page_size")); s != "" {
+		size, err := strconv.Atoi(s)
+		if err != nil || size < 1 || size > maxPageSize {
+			return pageParams{}, errors.ErrInvalid.
+				WithMsgf("page_size must be a number between 1 and %d", maxPageSize).
+				WithCausef("invalid page_size '%s'", s)
+		}
+		page.Size = size
+	}
+
+	if s := strings.TrimSpace(q.Get("page_token")); s != "" {
+		token, err := decodePageToken(s)
+		if err != nil {
+			return pageParams{}, errors.ErrInvalid.
+				WithMsgf("page_token is not valid").
+				WithCausef(err.Error())
+		} else if token.Query != page.query {
+			return pageParams{}, errors.ErrInvalid.
+				WithMsgf("page_token was issued for a different query").
+				WithCausef("page_token query '%s' does not match '%s'", token.Query, page.query)
+		}
+		page.After = &token.pageCursor
+	}
+
+	return page, nil
+}
+
+// hashQuery returns the hash of the query parameters that select and order
+// the listed items.
+func hashQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		if !findInArray(pagingParams, k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		_, _ = h.Write([]byte(k + "=" + strings.Join(q[k], ",") + "\n"))
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+// needsFullView returns true if configs and state must be retained in the
+// listed firehose definitions.
+func (lp listPage) needsFullView() bool {
+	return lp.View == viewFull || findInArray(lp.Fields, "configs") || findInArray(lp.Fields, "state")
+}
+
+// paginate returns the page of sorted definitions, along with the token for
+// fetching the next page (empty if this is the last page).
+func (lp listPage) paginate(arr []firehoseDefinition) ([]firehoseDefinition, string) {
+	return paginate(arr, lp.pageParams, lp.Descending, func(def firehoseDefinition) pageCursor {
+		switch lp.SortBy {
+		case sortByCreatedAt:
+			return pageCursor{Key: formatCursorTime(def.CreatedAt), Name: def.Name}
+		case sortByUpdatedAt:
+			return pageCursor{Key: formatCursorTime(def.UpdatedAt), Name: def.Name}
+		default:
+			return pageCursor{Key: def.Name, Name: def.Name}
+		}
+	})
+}
+
+// paginate returns the page of items following the cursor of the page.
+// Items must be sorted by their cursors, in descending order if desc is
+// set. Size 0 selects all the remaining items.
+func paginate[T any](arr []T, page pageParams, desc bool, cursorOf func(T) pageCursor) ([]T, string) {
+	if page.After != nil {
+		after := *page.After
+		arr = arr[sort.Search(len(arr), func(i int) bool {
+			if desc {
+				return cursorOf(arr[i]).before(after)
+			}
+			return after.before(cursorOf(arr[i]))
+		}):]
+	}
+
+	if page.Size == 0 || len(arr) <= page.Size {
+		return arr, ""
+	}
+	arr = arr[:page.Size]
+	return arr, encodePageToken(pageToken{pageCursor: cursorOf(arr[page.Size-1]), Query: page.query})
+}
+
+func (c pageCursor) before(other pageCursor) bool {
+	if c.Key != other.Key {
+		return c.Key < other.Key
+	}
+	return c.Name < other.Name
+}
+
+func formatCursorTime(t time.Time) string {
+	return t.UTC().Format(cursorTimeLayout)
+}
+
+func sortFirehoses(arr []firehoseDefinition, sortBy string, desc bool) {
+	less := func(i, j int) bool {
+		a, b := arr[i], arr[j]
+		switch sortBy {
+		case sortByCreatedAt:
+			if !a.CreatedAt.Equal(b.CreatedAt) {
+				return a.CreatedAt.Before(b.CreatedAt)
+			}
+		case sortByUpdatedAt:
+			if !a.UpdatedAt.Equal(b.UpdatedAt) {
+				return a.UpdatedAt.Before(b.UpdatedAt)
+			}
+		}
+		// name is unique within a project and acts as the tie-breaker
+		// to keep the order (and hence the pages) stable.
+		return a.Name < b.Name
+	}
+
+	sort.SliceStable(arr, func(i, j int) bool {
+		if desc {
+			return less(j, i)
+		}
+		return less(i, j)
+	})
+}
+
+func projectFields(arr []firehoseDefinition, fields []string) ([]map[string]interface{}, error) {
+	result := []map[string]interface{}{}
+	for _, def := range arr {
+		b, err := json.Marshal(def)
+		if err != nil {
+			return nil, err
+		}
+
+		var all map[string]interface{}
+		if err := json.Unmarshal(b, &all); err != nil {
+			return nil, err
+		}
+
+		projected := map[string]interface{}{}
+		for _, field := range fields {
+			if v, found := all[field]; found {
+				projected[field] = v
+			}
+		}
+		result = append(result, projected)
+	}
+	return result, nil
+}
+
+func encodePageToken(token pageToken) string {
+	b, _ := json.Marshal(token)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodePageToken(s string) (*pageToken, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var token pageToken
+	if err := json.Unmarshal(b, &token); err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
@@ -0,0 +1,162 @@
+package firehose
+
+import (
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func Test_listFilter_matches(t *testing.T) {
+	t.Parallel()
+
+	def := firehoseDefinition{
+		Name:        "booking-ingester",
+		Group:       "team-a",
+		KubeCluster: "orn:entropy:kubernetes:foo:bar",
+		Configs: &firehoseConfigs{
+			SinkType:   "BIGQUERY",
+			TopicName:  "booking-log",
+			StreamName: "main-kafka",
+		},
+		State: &firehoseState{State: stateStopped},
+	}
+
+	table := []struct {
+		title  string
+		filter listFilter
+		want   bool
+	}{
+		{title: "NoFilter", filter: listFilter{}, want: true},
+		{title: "AllMatching", filter: listFilter{Group: "team-a", Status: stateStopped, SinkType: "BIGQUERY", TopicName: "booking-log"}, want: true},
+		{title: "GroupMismatch", filter: listFilter{Group: "team-b"}, want: false},
+		{title: "StatusMismatch", filter: listFilter{Status: stateRunning}, want: false},
+		{title: "SinkTypeMismatch", filter: listFilter{SinkType: "LOG"}, want: false},
+		{title: "StreamMismatch", filter: listFilter{StreamName: "other-kafka"}, want: false},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.matches(def))
+		})
+	}
+}
+
+func Test_parseListPage(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Defaults", func(t *testing.T) {
+		page, err := parseListPage(httptest.NewRequest("GET", "/firehoses", nil))
+		require.NoError(t, err)
+		assert.Equal(t, sortByName, page.SortBy)
+		assert.Equal(t, viewBasic, page.View)
+		assert.Zero(t, page.Size)
+		assert.Nil(t, page.After)
+	})
+
+	t.Run("Valid", func(t *testing.T) {
+		const query = "sort_by=-created_at&group=foo"
+		token := encodePageToken(pageToken{
+			pageCursor: pageCursor{Key: "2022-06-23T16:00:00.000000000Z", Name: "a"},
+			Query:      hashQuery(mustParseQuery(t, query)),
+		})
+		req := httptest.NewRequest("GET", "/firehoses?page_size=2&page_token="+token+"&fields=urn,configs&"+query, nil)
+
+		page, err := parseListPage(req)
+		require.NoError(t, err)
+		assert.Equal(t, 2, page.Size)
+		assert.Equal(t, &pageCursor{Key: "2022-06-23T16:00:00.000000000Z", Name: "a"}, page.After)
+		assert.Equal(t, sortByCreatedAt, page.SortBy)
+		assert.True(t, page.Descending)
+		assert.True(t, page.needsFullView())
+	})
+
+	t.Run("TokenOfOtherQuery", func(t *testing.T) {
+		token := encodePageToken(pageToken{
+			pageCursor: pageCursor{Key: "a", Name: "a"},
+			Query:      hashQuery(mustParseQuery(t, "sort_by=name")),
+		})
+
+		_, err := parseListPage(httptest.NewRequest("GET", "/firehoses?sort_by=-name&page_token="+token, nil))
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		for _, query := range []string{"page_size=0", "page_token=@@@", "sort_by=title", "view=compact", "fields=urn,secrets"} {
+			_, err := parseListPage(httptest.NewRequest("GET", "/firehoses?"+query, nil))
+			assert.ErrorIs(t, err, errors.ErrInvalid, query)
+		}
+	})
+}
+
+func Test_listPage_paginate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	arr := []firehoseDefinition{
+		{Name: "c", CreatedAt: now},
+		{Name: "a", CreatedAt: now.Add(time.Minute)},
+		{Name: "b", CreatedAt: now.Add(-time.Minute)},
+	}
+
+	sortFirehoses(arr, sortByCreatedAt, true)
+	assert.Equal(t, "a", arr[0].Name)
+	assert.Equal(t, "b", arr[2].Name)
+
+	sortFirehoses(arr, sortByName, false)
+
+	page := listPage{pageParams: pageParams{Size: 2}, SortBy: sortByName}
+	items, next := page.paginate(arr)
+	require.Len(t, items, 2)
+	assert.Equal(t, "a", items[0].Name)
+	require.NotEmpty(t, next)
+
+	token, err := decodePageToken(next)
+	require.NoError(t, err)
+	assert.Equal(t, pageCursor{Key: "b", Name: "b"}, token.pageCursor)
+
+	// items added before the cursor must not shift the next page.
+	arr = append(arr, firehoseDefinition{Name: "0"})
+	sortFirehoses(arr, sortByName, false)
+
+	page.After = &token.pageCursor
+	items, next = page.paginate(arr)
+	require.Len(t, items, 1)
+	assert.Equal(t, "c", items[0].Name)
+	assert.Empty(t, next)
+
+	t.Run("Descending", func(t *testing.T) {
+		sortFirehoses(arr, sortByCreatedAt, true)
+
+		page := listPage{pageParams: pageParams{Size: 1}, SortBy: sortByCreatedAt, Descending: true}
+		var names []string
+		for {
+			items, next := page.paginate(arr)
+			for _, item := range items {
+				names = append(names, item.Name)
+			}
+			if next == "" {
+				break
+			}
+
+			token, err := decodePageToken(next)
+			require.NoError(t, err)
+			page.After = &token.pageCursor
+		}
+		assert.Equal(t, []string{"a", "c", "b", "0"}, names)
+	})
+}
+
+func mustParseQuery(t *testing.T, query string) url.Values {
+	t.Helper()
+
+	q, err := url.ParseQuery(query)
+	require.NoError(t, err)
+	return q
+}
@@ -2,6 +2,7 @@ package firehose
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -14,7 +15,13 @@ import (
 	"github.com/odpf/dex/pkg/errors"
 )
 
-const resourceDepKey = "kube_cluster"
+const (
+	resourceDepKey = "kube_cluster"
+
+	// labelRolledBackTo is set on the revision created by a rollback, with
+	// the ID of the revision that was restored.
+	labelRolledBackTo = "rolled_back_to"
+)
 
 type firehoseDefinition struct {
 	URN         string           `json:"urn"`
@@ -35,6 +42,9 @@ type firehoseMetadata struct {
 	CreatedByEmail string
 	UpdatedBy      string
 	UpdatedByEmail string
+	AlertSnapshot  string
+	AlertSilences  string
+	DefaultAlerts  string
 }
 
 type firehoseConfigs struct {
@@ -67,6 +77,19 @@ type firehoseLabels struct {
 	CreatedByEmail string `mapstructure:"created_by_email"`
 	UpdatedBy      string `mapstructure:"updated_by"`
 	UpdatedByEmail string `mapstructure:"updated_by_email"`
+
+	// AlertSnapshot is the comma separated list of alert rule templates
+	// that were enabled when the firehose was last stopped.
+	AlertSnapshot string `mapstructure:"alert_snapshot,omitempty"`
+
+	// AlertSilences is the JSON encoded list of alert silences of the
+	// firehose.
+	AlertSilences string `mapstructure:"alert_silences,omitempty"`
+
+	// DefaultAlerts is set to defaultAlertsPending when the default alert
+	// policy of the project could not be applied on creation, since the
+	// release name of the firehose was not known yet.
+	DefaultAlerts string `mapstructure:"default_alerts,omitempty"`
 }
 
 type moduleConfig struct {
@@ -86,7 +109,10 @@ type moduleConfigFirehoseDef struct {
 }
 
 type revisionDiff struct {
+	ID        string            `json:"id"`
+	BaseID    string            `json:"base_id,omitempty"`
 	Diff      json.RawMessage   `json:"diff"`
+	Spec      json.RawMessage   `json:"spec,omitempty"`
 	Labels    map[string]string `json:"labels"`
 	UpdatedAt time.Time         `json:"updated_at"`
 }
@@ -164,6 +190,9 @@ func mapResourceToFirehose(res *entropyv1beta1.Resource, onlyMeta bool) (*fireho
 			CreatedByEmail: labels.CreatedByEmail,
 			UpdatedBy:      labels.UpdatedBy,
 			UpdatedByEmail: labels.UpdatedByEmail,
+			AlertSnapshot:  labels.AlertSnapshot,
+			AlertSilences:  labels.AlertSilences,
+			DefaultAlerts:  labels.DefaultAlerts,
 		},
 	}
 
@@ -185,7 +214,7 @@ func mapResourceToFirehose(res *entropyv1beta1.Resource, onlyMeta bool) (*fireho
 		def.State = &firehoseState{
 			State:  modConf.State,
 			Status: res.GetState().GetStatus().String(),
-			Output: res.GetState().Output.GetStructValue().AsMap(),
+			Output: res.GetState().GetOutput().GetStructValue().AsMap(),
 		}
 	}
 
@@ -193,15 +222,23 @@ func mapResourceToFirehose(res *entropyv1beta1.Resource, onlyMeta bool) (*fireho
 }
 
 func (fd firehoseDefinition) getLabels() firehoseLabels {
-	return firehoseLabels{
-		Title:          fd.Title,
-		Group:          fd.Group,
-		Description:    fd.Description,
-		CreatedBy:      fd.metadata.CreatedBy,
-		CreatedByEmail: fd.metadata.CreatedByEmail,
-		UpdatedBy:      fd.metadata.UpdatedBy,
-		UpdatedByEmail: fd.metadata.UpdatedByEmail,
+	labels := firehoseLabels{
+		Title:       fd.Title,
+		Group:       fd.Group,
+		Description: fd.Description,
 	}
+
+	// metadata is not set for definitions decoded from request body.
+	if fd.metadata != nil {
+		labels.CreatedBy = fd.metadata.CreatedBy
+		labels.CreatedByEmail = fd.metadata.CreatedByEmail
+		labels.UpdatedBy = fd.metadata.UpdatedBy
+		labels.UpdatedByEmail = fd.metadata.UpdatedByEmail
+		labels.AlertSnapshot = fd.metadata.AlertSnapshot
+		labels.AlertSilences = fd.metadata.AlertSilences
+		labels.DefaultAlerts = fd.metadata.DefaultAlerts
+	}
+	return labels
 }
 
 func (fl firehoseLabels) toMap() (map[string]string, error) {
@@ -227,29 +264,93 @@ func (fl *firehoseLabels) setUpdatedBy(ctx reqctx.ReqCtx) {
 	fl.UpdatedByEmail = ctx.UserEmail
 }
 
+func (fl *firehoseLabels) setAlertSnapshot(templates []string) {
+	fl.AlertSnapshot = strings.Join(templates, ",")
+}
+
+func (fl firehoseLabels) alertSnapshot() []string {
+	var templates []string
+	for _, t := range strings.Split(fl.AlertSnapshot, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			templates = append(templates, t)
+		}
+	}
+	return templates
+}
+
+func (fl *firehoseLabels) setAlertSilences(silences []alertSilence) error {
+	if len(silences) == 0 {
+		fl.AlertSilences = ""
+		return nil
+	}
+
+	b, err := json.Marshal(silences)
+	if err != nil {
+		return err
+	}
+	fl.AlertSilences = string(b)
+	return nil
+}
+
+func (fl firehoseLabels) alertSilences() ([]alertSilence, error) {
+	if fl.AlertSilences == "" {
+		return nil, nil
+	}
+
+	var silences []alertSilence
+	if err := json.Unmarshal([]byte(fl.AlertSilences), &silences); err != nil {
+		return nil, errors.ErrInternal.
+			WithMsgf("alert silences of the firehose are not valid").
+			WithCausef(err.Error())
+	}
+	return silences, nil
+}
+
 func (fl *firehoseLabels) setCreatedBy(ctx reqctx.ReqCtx) {
 	fl.CreatedBy = ctx.UserID
 	fl.CreatedByEmail = ctx.UserEmail
 }
 
+// toConfigStruct returns the module config for a new firehose.
 func (fc firehoseConfigs) toConfigStruct(prj *shieldv1beta1.Project) (*structpb.Value, error) {
-	const defaultState = "RUNNING"
-
-	metadata := prj.GetMetadata().AsMap()
-	telegrafConf, _ := metadata["telegraf"].(map[string]interface{})
-
-	return toProtobufStruct(moduleConfig{
-		State:    defaultState,
-		StopTime: fc.StopDate,
-		Telegraf: telegrafConf,
-		Firehose: moduleConfigFirehoseDef{
-			Replicas:           fc.Replicas,
-			KafkaBrokerAddress: fc.BootstrapServers,
-			KafkaTopic:         fc.TopicName,
-			KafkaConsumerID:    fc.ConsumerGroupID,
-			EnvVariables:       fc.EnvVars,
-		},
-	})
+	return fc.mergeConfigStruct(nil, "", prj)
+}
+
+// mergeConfigStruct applies the firehose configs on top of the current
+// module config. State, stop-time, chart version and telegraf configs of
+// the current module config are retained unless explicitly set. 'cur'
+// must be nil for new firehoses.
+func (fc firehoseConfigs) mergeConfigStruct(cur *structpb.Value, state string, prj *shieldv1beta1.Project) (*structpb.Value, error) {
+	modConf := moduleConfig{State: stateRunning}
+	if cur != nil {
+		if err := protoStructToGo(cur, &modConf); err != nil {
+			return nil, err
+		}
+	}
+
+	if modConf.Telegraf == nil {
+		metadata := prj.GetMetadata().AsMap()
+		modConf.Telegraf, _ = metadata["telegraf"].(map[string]interface{})
+	}
+
+	if state != "" {
+		modConf.State = state
+	}
+	if fc.Version != "" {
+		modConf.ChartVersion = fc.Version
+	}
+	if fc.StopDate != nil {
+		modConf.StopTime = fc.StopDate
+	}
+
+	modConf.Firehose = moduleConfigFirehoseDef{
+		Replicas:           fc.Replicas,
+		KafkaBrokerAddress: fc.BootstrapServers,
+		KafkaTopic:         fc.TopicName,
+		KafkaConsumerID:    fc.ConsumerGroupID,
+		EnvVariables:       fc.EnvVars,
+	}
+	return toProtobufStruct(modConf)
 }
 
 func toProtobufStruct(v interface{}) (*structpb.Value, error) {
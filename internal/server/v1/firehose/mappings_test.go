@@ -0,0 +1,66 @@
+package firehose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_firehoseConfigs_mergeConfigStruct(t *testing.T) {
+	t.Parallel()
+
+	cur, err := toProtobufStruct(moduleConfig{
+		State:        stateStopped,
+		ChartVersion: "0.1.3",
+		Telegraf:     map[string]interface{}{"enabled": true},
+		Firehose:     moduleConfigFirehoseDef{Replicas: 2},
+	})
+	require.NoError(t, err)
+
+	fc := firehoseConfigs{Replicas: 1, TopicName: "bookings", EnvVars: map[string]string{"SINK_TYPE": "LOG"}}
+
+	t.Run("RetainsCurrent", func(t *testing.T) {
+		merged, err := fc.mergeConfigStruct(cur, "", nil)
+		require.NoError(t, err)
+
+		var got moduleConfig
+		require.NoError(t, protoStructToGo(merged, &got))
+		assert.Equal(t, stateStopped, got.State)
+		assert.Equal(t, "0.1.3", got.ChartVersion)
+		assert.Equal(t, map[string]interface{}{"enabled": true}, got.Telegraf)
+		assert.Equal(t, 1, got.Firehose.Replicas)
+		assert.Equal(t, "bookings", got.Firehose.KafkaTopic)
+	})
+
+	t.Run("ExplicitlySet", func(t *testing.T) {
+		fc := fc
+		fc.Version = "0.2.0"
+
+		merged, err := fc.mergeConfigStruct(cur, stateRunning, nil)
+		require.NoError(t, err)
+
+		var got moduleConfig
+		require.NoError(t, protoStructToGo(merged, &got))
+		assert.Equal(t, stateRunning, got.State)
+		assert.Equal(t, "0.2.0", got.ChartVersion)
+	})
+}
+
+func Test_firehoseLabels_alertSnapshot(t *testing.T) {
+	t.Parallel()
+
+	var labels firehoseLabels
+	m, err := labels.toMap()
+	require.NoError(t, err)
+	assert.NotContains(t, m, "alert_snapshot")
+
+	labels.setAlertSnapshot([]string{"lag", "down"})
+	m, err = labels.toMap()
+	require.NoError(t, err)
+	assert.Equal(t, "lag,down", m["alert_snapshot"])
+
+	decoded, err := toFirehoseLabels(m)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"lag", "down"}, decoded.alertSnapshot())
+}
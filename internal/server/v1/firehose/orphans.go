@@ -0,0 +1,140 @@
+package firehose
+
+import (
+	"context"
+	"net/http"
+
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+
+	"github.com/odpf/dex/internal/server/utils"
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+)
+
+// purgeOrphanedAlertPolicyResult is the outcome of purging a single orphaned
+// alert policy.
+type purgeOrphanedAlertPolicyResult struct {
+	Resource string           `json:"resource"`
+	Policy   *alertsv1.Policy `json:"policy,omitempty"`
+	Error    string           `json:"error,omitempty"`
+}
+
+func handleListOrphanedAlertPolicies(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		orphans, err := findOrphanedAlertPolicies(r.Context(), client, svc, prj.GetSlug())
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusOK, listResponse[alertsv1.Policy]{Items: orphans})
+	}
+}
+
+func handlePurgeOrphanedAlertPolicies(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		orphans, err := findOrphanedAlertPolicies(r.Context(), client, svc, prj.GetSlug())
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		// a failure is reported in the result of the policy, so that it
+		// does not stop the rest of the policies from being purged.
+		results := []purgeOrphanedAlertPolicyResult{}
+		for _, policy := range orphans {
+			policy := policy
+			res := purgeOrphanedAlertPolicyResult{Resource: policy.Resource}
+			if err := svc.DisableAlertPolicy(r.Context(), prj.GetSlug(), policy.Resource); err != nil {
+				res.Error = err.Error()
+			} else {
+				res.Policy = &policy
+			}
+			results = append(results, res)
+		}
+
+		utils.WriteJSON(w, http.StatusOK, listResponse[purgeOrphanedAlertPolicyResult]{Items: results})
+	}
+}
+
+// findOrphanedAlertPolicies returns the firehose alert policies in the alert
+// namespace of the project with enabled rules for firehoses that no longer
+// exist. Since a namespace can be shared, firehoses of all the projects of
+// the namespace are considered. Only the enabled rules are returned.
+func findOrphanedAlertPolicies(ctx context.Context, client entropyv1beta1.ResourceServiceClient, svc *alertsv1.Service, projectSlug string) ([]alertsv1.Policy, error) {
+	nsProjects, err := svc.NamespaceProjects(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]bool{}
+	for _, slug := range nsProjects {
+		resp, err := client.ListResources(ctx, &entropyv1beta1.ListResourcesRequest{
+			Kind:    kindFirehose,
+			Project: slug,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range resp.GetResources() {
+			existing[res.GetName()] = true
+
+			firehoseDef, err := mapResourceToFirehose(res, false)
+			if err != nil {
+				return nil, err
+			}
+			if name, err := getFirehoseReleaseName(firehoseDef); err == nil {
+				existing[name] = true
+			}
+		}
+	}
+
+	// only rules created from firehose templates are considered, since the
+	// namespace might have rules of other kinds of resources.
+	templates, err := svc.ListAlertTemplates(ctx, kindFirehose)
+	if err != nil {
+		return nil, err
+	}
+	firehoseTemplates := map[string]bool{}
+	for _, t := range templates {
+		firehoseTemplates[t.Name] = true
+	}
+
+	policies, err := svc.ListAlertPolicies(ctx, projectSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	orphans := []alertsv1.Policy{}
+	for _, policy := range policies {
+		if existing[policy.Resource] {
+			continue
+		}
+
+		var enabled []alertsv1.Rule
+		for _, rule := range policy.Rules {
+			if rule.Enabled && firehoseTemplates[rule.Template] {
+				enabled = append(enabled, rule)
+			}
+		}
+
+		if len(enabled) > 0 {
+			policy.Rules = enabled
+			orphans = append(orphans, policy)
+		}
+	}
+	return orphans, nil
+}
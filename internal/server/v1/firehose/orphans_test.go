@@ -0,0 +1,148 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	sirenv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/siren/v1beta1"
+	"google.golang.org/grpc"
+
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func (f *fakeResourceClient) ListResources(_ context.Context, in *entropyv1beta1.ListResourcesRequest, _ ...grpc.CallOption) (*entropyv1beta1.ListResourcesResponse, error) {
+	var resources []*entropyv1beta1.Resource
+	for _, res := range f.resources {
+		if res.GetKind() == in.GetKind() && (in.GetProject() == "" || res.GetProject() == in.GetProject()) {
+			resources = append(resources, res)
+		}
+	}
+	return &entropyv1beta1.ListResourcesResponse{Resources: resources}, nil
+}
+
+type fakeSirenClient struct {
+	sirenv1beta1.SirenServiceClient
+	namespaces []*sirenv1beta1.Namespace
+	templates  []*sirenv1beta1.Template
+	rules      []*sirenv1beta1.Rule
+}
+
+func (f *fakeSirenClient) ListNamespaces(_ context.Context, _ *sirenv1beta1.ListNamespacesRequest, _ ...grpc.CallOption) (*sirenv1beta1.ListNamespacesResponse, error) {
+	return &sirenv1beta1.ListNamespacesResponse{Namespaces: f.namespaces}, nil
+}
+
+func (f *fakeSirenClient) ListTemplates(_ context.Context, _ *sirenv1beta1.ListTemplatesRequest, _ ...grpc.CallOption) (*sirenv1beta1.ListTemplatesResponse, error) {
+	return &sirenv1beta1.ListTemplatesResponse{Templates: f.templates}, nil
+}
+
+func (f *fakeSirenClient) ListRules(_ context.Context, in *sirenv1beta1.ListRulesRequest, _ ...grpc.CallOption) (*sirenv1beta1.ListRulesResponse, error) {
+	var rules []*sirenv1beta1.Rule
+	for _, r := range f.rules {
+		if in.GetNamespace() == "" || r.GetNamespace() == in.GetNamespace() {
+			rules = append(rules, r)
+		}
+	}
+	return &sirenv1beta1.ListRulesResponse{Rules: rules}, nil
+}
+
+func Test_findOrphanedAlertPolicies(t *testing.T) {
+	t.Parallel()
+
+	configs, err := toProtobufStruct(moduleConfig{})
+	require.NoError(t, err)
+	spec := &entropyv1beta1.ResourceSpec{Configs: configs}
+
+	client := &fakeResourceClient{
+		resources: map[string]*entropyv1beta1.Resource{
+			"orn:foo:firehose:a:f1": {Urn: "orn:foo:firehose:a:f1", Kind: kindFirehose, Project: "a", Name: "f1", Spec: spec, State: &entropyv1beta1.ResourceState{}},
+			"orn:foo:firehose:b:f2": {Urn: "orn:foo:firehose:b:f2", Kind: kindFirehose, Project: "b", Name: "f2", Spec: spec, State: &entropyv1beta1.ResourceState{}},
+		},
+	}
+	siren := &fakeSirenClient{
+		namespaces: []*sirenv1beta1.Namespace{
+			{Id: 1, Name: "ns-1", Labels: map[string]string{"projects": "a,b"}},
+		},
+		templates: []*sirenv1beta1.Template{{Name: "lag"}},
+		rules: []*sirenv1beta1.Rule{
+			{Namespace: "f1", Template: "lag", Enabled: true},
+			{Namespace: "f2", Template: "lag", Enabled: true},
+			{Namespace: "gone", Template: "lag", Enabled: true},
+			{Namespace: "gone", Template: "other-kind", Enabled: true},
+			{Namespace: "gone-disabled", Template: "lag", Enabled: false},
+		},
+	}
+	svc := alertsv1.NewService(siren, alertsv1.NamespaceCacheConfig{})
+
+	orphans, err := findOrphanedAlertPolicies(context.Background(), client, svc, "a")
+	require.NoError(t, err)
+	require.Len(t, orphans, 1)
+	assert.Equal(t, "gone", orphans[0].Resource)
+	require.Len(t, orphans[0].Rules, 1)
+	assert.Equal(t, "lag", orphans[0].Rules[0].Template)
+}
+
+// failingRuleSirenClient fails the rule updates of the given namespace.
+type failingRuleSirenClient struct {
+	fakeRuleSirenClient
+	failFor string
+}
+
+func (f *failingRuleSirenClient) UpdateRule(ctx context.Context, in *sirenv1beta1.UpdateRuleRequest, opts ...grpc.CallOption) (*sirenv1beta1.UpdateRuleResponse, error) {
+	if in.GetNamespace() == f.failFor {
+		return nil, errors.ErrInternal
+	}
+	return f.fakeRuleSirenClient.UpdateRule(ctx, in, opts...)
+}
+
+func Test_handlePurgeOrphanedAlertPolicies(t *testing.T) {
+	t.Parallel()
+
+	client := &fakeResourceClient{resources: map[string]*entropyv1beta1.Resource{}}
+	siren := &failingRuleSirenClient{
+		fakeRuleSirenClient: fakeRuleSirenClient{fakeSirenClient{
+			namespaces: []*sirenv1beta1.Namespace{
+				{Id: 1, Name: "ns-1", Labels: map[string]string{"projects": "foo"}},
+			},
+			templates: []*sirenv1beta1.Template{{Name: "lag"}},
+			rules: []*sirenv1beta1.Rule{
+				{Namespace: "gone-1", Template: "lag", Enabled: true},
+				{Namespace: "gone-2", Template: "lag", Enabled: true},
+			},
+		}},
+		failFor: "gone-1",
+	}
+	svc := alertsv1.NewService(siren, alertsv1.NamespaceCacheConfig{})
+	shield := &fakeShieldClient{projects: []*shieldv1beta1.Project{{Id: "p1", Slug: "foo"}}}
+	projects := projectsv1.NewResolver(shield, projectsv1.CacheConfig{})
+
+	req := httptest.NewRequest("DELETE", "/projects/foo/orphanedAlertPolicies", nil)
+	req = mux.SetURLVars(req, map[string]string{pathParamProjectSlug: "foo"})
+	rec := httptest.NewRecorder()
+	handlePurgeOrphanedAlertPolicies(client, projects, svc).ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp listResponse[purgeOrphanedAlertPolicyResult]
+	require.NoError(t, json.NewDecoder(rec.Body).Decode(&resp))
+	require.Len(t, resp.Items, 2)
+
+	// failure of the first policy does not stop the second one.
+	assert.Equal(t, "gone-1", resp.Items[0].Resource)
+	assert.NotEmpty(t, resp.Items[0].Error)
+	assert.Nil(t, resp.Items[0].Policy)
+	assert.True(t, siren.rules[0].Enabled)
+
+	assert.Equal(t, "gone-2", resp.Items[1].Resource)
+	assert.Empty(t, resp.Items[1].Error)
+	require.NotNil(t, resp.Items[1].Policy)
+	assert.False(t, siren.rules[1].Enabled)
+}
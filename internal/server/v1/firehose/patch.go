@@ -0,0 +1,289 @@
+package firehose
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	contentTypeMergePatch = "application/merge-patch+json"
+	contentTypeJSONPatch  = "application/json-patch+json"
+)
+
+// applyPatch applies the patch document of the given content-type on the
+// JSON document and returns the patched document.
+func applyPatch(contentType string, doc, patch []byte) ([]byte, error) {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = contentType
+	}
+
+	var target interface{}
+	if err := json.Unmarshal(doc, &target); err != nil {
+		return nil, errors.ErrInternal.WithCausef(err.Error())
+	}
+
+	var patched interface{}
+	switch mediaType {
+	case contentTypeMergePatch:
+		var mp interface{}
+		if err := json.Unmarshal(patch, &mp); err != nil {
+			return nil, errors.ErrInvalid.
+				WithMsgf("merge patch is not valid json").
+				WithCausef(err.Error())
+		}
+		patched = mergePatch(target, mp)
+
+	case contentTypeJSONPatch:
+		var ops []jsonPatchOp
+		if err := json.Unmarshal(patch, &ops); err != nil {
+			return nil, errors.ErrInvalid.
+				WithMsgf("json patch must be an array of operations").
+				WithCausef(err.Error())
+		}
+
+		patched, err = applyJSONPatch(target, ops)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, errors.ErrUnsupportedMediaType.
+			WithMsgf("Content-Type must be one of %s, %s", contentTypeMergePatch, contentTypeJSONPatch).
+			WithCausef("unsupported content-type '%s'", contentType)
+	}
+
+	return json.Marshal(patched)
+}
+
+// mergePatch applies the patch as per RFC 7396.
+func mergePatch(target, patch interface{}) interface{} {
+	pm, isMap := patch.(map[string]interface{})
+	if !isMap {
+		return patch
+	}
+
+	tm, isMap := target.(map[string]interface{})
+	if !isMap {
+		tm = map[string]interface{}{}
+	}
+
+	for k, v := range pm {
+		if v == nil {
+			delete(tm, k)
+		} else {
+			tm[k] = mergePatch(tm[k], v)
+		}
+	}
+	return tm
+}
+
+// applyJSONPatch applies the operations as per RFC 6902.
+func applyJSONPatch(doc interface{}, ops []jsonPatchOp) (interface{}, error) {
+	var err error
+	for i, op := range ops {
+		doc, err = applyJSONPatchOp(doc, op)
+		if err != nil {
+			return nil, errors.ErrInvalid.
+				WithMsgf("json patch operation %d (%s %s) failed: %s", i, op.Op, op.Path, err).
+				WithCausef(err.Error())
+		}
+	}
+	return doc, nil
+}
+
+func applyJSONPatchOp(doc interface{}, op jsonPatchOp) (interface{}, error) {
+	value := func() (interface{}, error) {
+		if op.Value == nil {
+			return nil, fmt.Errorf("value is required")
+		}
+		var v interface{}
+		err := json.Unmarshal(op.Value, &v)
+		return v, err
+	}
+
+	switch op.Op {
+	case "add":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		return updatePointer(doc, op.Path, v, true)
+
+	case "replace":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+		return updatePointer(doc, op.Path, v, false)
+
+	case "remove":
+		return updatePointer(doc, op.Path, removeValue{}, false)
+
+	case "test":
+		v, err := value()
+		if err != nil {
+			return nil, err
+		}
+
+		cur, err := getPointer(doc, op.Path)
+		if err != nil {
+			return nil, err
+		} else if !reflect.DeepEqual(cur, v) {
+			return nil, fmt.Errorf("value does not match")
+		}
+		return doc, nil
+
+	case "copy", "move":
+		v, err := getPointer(doc, op.From)
+		if err != nil {
+			return nil, err
+		}
+
+		if op.Op == "move" {
+			doc, err = updatePointer(doc, op.From, removeValue{}, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return updatePointer(doc, op.Path, v, true)
+
+	default:
+		return nil, fmt.Errorf("unknown operation '%s'", op.Op)
+	}
+}
+
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	} else if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("path must start with '/'")
+	}
+
+	unescaper := strings.NewReplacer("~1", "/", "~0", "~")
+	parts := strings.Split(pointer[1:], "/")
+	for i, p := range parts {
+		parts[i] = unescaper.Replace(p)
+	}
+	return parts, nil
+}
+
+func getPointer(doc interface{}, pointer string) (interface{}, error) {
+	parts, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	cur := doc
+	for _, key := range parts {
+		switch node := cur.(type) {
+		case map[string]interface{}:
+			v, found := node[key]
+			if !found {
+				return nil, fmt.Errorf("path does not exist")
+			}
+			cur = v
+
+		case []interface{}:
+			idx, err := strconv.Atoi(key)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil, fmt.Errorf("array index '%s' is not valid", key)
+			}
+			cur = node[idx]
+
+		default:
+			return nil, fmt.Errorf("path does not exist")
+		}
+	}
+	return cur, nil
+}
+
+// removeValue is set at a pointer to remove the value there. nil cannot
+// be used for removal since it is the JSON null.
+type removeValue struct{}
+
+// updatePointer sets the value at the pointer and returns the updated
+// document. Value is removed if it is removeValue. If insert is true,
+// values are inserted into arrays instead of replacing existing entries
+// and missing object members are allowed.
+func updatePointer(doc interface{}, pointer string, value interface{}, insert bool) (interface{}, error) {
+	_, remove := value.(removeValue)
+
+	parts, err := parsePointer(pointer)
+	if err != nil {
+		return nil, err
+	} else if len(parts) == 0 {
+		if remove {
+			return nil, fmt.Errorf("document root cannot be removed")
+		}
+		return value, nil
+	}
+
+	parentPointer := ""
+	if len(parts) > 1 {
+		parentPointer = "/" + strings.Join(escapePointerParts(parts[:len(parts)-1]), "/")
+	}
+
+	parent, err := getPointer(doc, parentPointer)
+	if err != nil {
+		return nil, err
+	}
+
+	key := parts[len(parts)-1]
+	switch node := parent.(type) {
+	case map[string]interface{}:
+		if _, found := node[key]; !found && !insert {
+			return nil, fmt.Errorf("path does not exist")
+		}
+
+		if remove {
+			delete(node, key)
+		} else {
+			node[key] = value
+		}
+		return doc, nil
+
+	case []interface{}:
+		idx := len(node)
+		if key != "-" {
+			idx, err = strconv.Atoi(key)
+			if err != nil || idx < 0 || idx > len(node) || (!insert && idx == len(node)) {
+				return nil, fmt.Errorf("array index '%s' is not valid", key)
+			}
+		} else if !insert {
+			return nil, fmt.Errorf("array index '-' is valid only for add")
+		}
+
+		var updated []interface{}
+		switch {
+		case remove:
+			updated = append(append(updated, node[:idx]...), node[idx+1:]...)
+		case insert:
+			updated = append(append(append(updated, node[:idx]...), value), node[idx:]...)
+		default:
+			updated = append(append(append(updated, node[:idx]...), value), node[idx+1:]...)
+		}
+
+		// arrays are values, so the parent needs to be updated as well.
+		return updatePointer(doc, parentPointer, updated, false)
+
+	default:
+		return nil, fmt.Errorf("path does not exist")
+	}
+}
+
+func escapePointerParts(parts []string) []string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+
+	escaped := make([]string, len(parts))
+	for i, p := range parts {
+		escaped[i] = escaper.Replace(p)
+	}
+	return escaped
+}
@@ -0,0 +1,62 @@
+package firehose
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func Test_applyPatch(t *testing.T) {
+	t.Parallel()
+
+	doc := []byte(`{"description": "foo", "configs": {"replicas": 1, "env_vars": {"A": "1", "B": "2"}, "tags": ["x"]}}`)
+
+	t.Run("MergePatch", func(t *testing.T) {
+		patch := []byte(`{"configs": {"env_vars": {"A": null, "C": "3"}}}`)
+
+		got, err := applyPatch("application/merge-patch+json; charset=utf-8", doc, patch)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"description": "foo", "configs": {"replicas": 1, "env_vars": {"B": "2", "C": "3"}, "tags": ["x"]}}`, string(got))
+	})
+
+	t.Run("JSONPatch", func(t *testing.T) {
+		patch := []byte(`[
+			{"op": "test", "path": "/configs/replicas", "value": 1},
+			{"op": "replace", "path": "/configs/replicas", "value": 2},
+			{"op": "move", "from": "/configs/env_vars/A", "path": "/configs/env_vars/D"},
+			{"op": "add", "path": "/configs/tags/-", "value": "y"},
+			{"op": "remove", "path": "/description"}
+		]`)
+
+		got, err := applyPatch(contentTypeJSONPatch, doc, patch)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"configs": {"replicas": 2, "env_vars": {"B": "2", "D": "1"}, "tags": ["x", "y"]}}`, string(got))
+	})
+
+	t.Run("JSONPatchNullValues", func(t *testing.T) {
+		patch := []byte(`[
+			{"op": "add", "path": "/configs/env_vars/C", "value": null},
+			{"op": "replace", "path": "/configs/tags/0", "value": null},
+			{"op": "move", "from": "/configs/env_vars/C", "path": "/configs/env_vars/D"}
+		]`)
+
+		got, err := applyPatch(contentTypeJSONPatch, doc, patch)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"description": "foo", "configs": {"replicas": 1, "env_vars": {"A": "1", "B": "2", "D": null}, "tags": [null]}}`, string(got))
+	})
+
+	t.Run("FailedTest", func(t *testing.T) {
+		patch := []byte(`[{"op": "test", "path": "/configs/replicas", "value": 3}]`)
+
+		_, err := applyPatch(contentTypeJSONPatch, doc, patch)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("UnsupportedContentType", func(t *testing.T) {
+		_, err := applyPatch("application/json", doc, []byte(`{}`))
+		assert.ErrorIs(t, err, errors.ErrUnsupportedMediaType)
+	})
+}
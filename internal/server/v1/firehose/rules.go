@@ -0,0 +1,159 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+
+	"github.com/odpf/dex/internal/server/utils"
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const pathParamTemplate = "template"
+
+// alertTarget is the firehose whose alert rules are being managed.
+type alertTarget struct {
+	prj         *shieldv1beta1.Project
+	firehoseDef *firehoseDefinition
+	name        string
+}
+
+func getAlertTarget(r *http.Request, client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) (*alertTarget, error) {
+	prj, err := getProject(r, projects)
+	if err != nil {
+		return nil, err
+	}
+
+	firehoseDef, err := getFirehoseResource(r.Context(), client, prj, mux.Vars(r)[pathParamURN])
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := getFirehoseReleaseName(firehoseDef)
+	if err != nil {
+		return nil, err
+	}
+
+	return &alertTarget{prj: prj, firehoseDef: firehoseDef, name: name}, nil
+}
+
+// suppliedAlertVariables returns the rule variables that are filled in by
+// Dex for the firehose instead of the user.
+func suppliedAlertVariables(ctx context.Context, svc *alertsv1.Service, target *alertTarget) (map[string]string, error) {
+	entity, err := svc.GetProjectDataSource(ctx, target.prj.GetSlug())
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]string{
+		"team":   target.firehoseDef.Group,
+		"name":   target.name,
+		"entity": entity,
+	}, nil
+}
+
+// applyAlertPolicy binds the supplied variables of the target firehose to
+// the policy rules and upserts the policy for the firehose.
+func applyAlertPolicy(ctx context.Context, svc *alertsv1.Service, target *alertTarget, policy alertsv1.Policy) (*alertsv1.Policy, error) {
+	vars, err := suppliedAlertVariables(ctx, svc, target)
+	if err != nil {
+		return nil, err
+	}
+
+	policy.Rules, err = svc.ValidateRules(ctx, addSuppliedVariablesFromRules(policy.Rules, vars), suppliedAlertVariableNames)
+	if err != nil {
+		return nil, err
+	}
+	policy.Resource = target.name
+
+	return svc.UpsertAlertPolicy(ctx, target.prj.GetSlug(), policy)
+}
+
+func handleGetFirehoseAlertRule(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		target, err := getAlertTarget(r, client, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		rule, err := svc.GetAlertRule(r.Context(), target.prj.GetSlug(), target.name, mux.Vars(r)[pathParamTemplate])
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusOK, removeSuppliedVariablesFromRules([]alertsv1.Rule{*rule}, suppliedAlertVariableNames)[0])
+	}
+}
+
+func handleUpsertFirehoseAlertRule(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		target, err := getAlertTarget(r, client, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		var rule alertsv1.Rule
+		if err := json.NewDecoder(r.Body).Decode(&rule); err != nil {
+			utils.WriteErr(w, errors.ErrInvalid.
+				WithMsgf("request json body is not valid").
+				WithCausef(err.Error()))
+			return
+		}
+		rule.Template = mux.Vars(r)[pathParamTemplate]
+
+		vars, err := suppliedAlertVariables(ctx, svc, target)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+		validated, err := svc.ValidateRules(ctx, addSuppliedVariablesFromRules([]alertsv1.Rule{rule}, vars), suppliedAlertVariableNames)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+		rule = validated[0]
+
+		updated, err := svc.UpsertAlertRule(ctx, target.prj.GetSlug(), target.name, rule)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusOK, removeSuppliedVariablesFromRules([]alertsv1.Rule{*updated}, suppliedAlertVariableNames)[0])
+	}
+}
+
+// handleSetFirehoseAlertRuleEnabled enables or disables a rule. Siren does
+// not support deleting rules, so deleting a rule disables it as well.
+func handleSetFirehoseAlertRuleEnabled(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service, enabled bool) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		target, err := getAlertTarget(r, client, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		updated, err := svc.SetAlertRuleEnabled(r.Context(), target.prj.GetSlug(), target.name, mux.Vars(r)[pathParamTemplate], enabled)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		if r.Method == http.MethodDelete {
+			utils.WriteJSON(w, http.StatusNoContent, nil)
+			return
+		}
+		utils.WriteJSON(w, http.StatusOK, removeSuppliedVariablesFromRules([]alertsv1.Rule{*updated}, suppliedAlertVariableNames)[0])
+	}
+}
@@ -0,0 +1,154 @@
+package firehose
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+const jsonSchemaDraft = "http://json-schema.org/draft-07/schema#"
+
+// jsonSchema is the subset of JSON Schema (draft-07) needed to describe
+// firehose configs.
+type jsonSchema struct {
+	Schema        string                 `json:"$schema,omitempty"`
+	ID            string                 `json:"$id,omitempty"`
+	Title         string                 `json:"title,omitempty"`
+	Description   string                 `json:"description,omitempty"`
+	Type          string                 `json:"type,omitempty"`
+	Format        string                 `json:"format,omitempty"`
+	Pattern       string                 `json:"pattern,omitempty"`
+	Enum          []string               `json:"enum,omitempty"`
+	Default       interface{}            `json:"default,omitempty"`
+	Minimum       *int                   `json:"minimum,omitempty"`
+	ReadOnly      bool                   `json:"readOnly,omitempty"`
+	Required      []string               `json:"required,omitempty"`
+	Properties    map[string]*jsonSchema `json:"properties,omitempty"`
+	PropertyNames *jsonSchema            `json:"propertyNames,omitempty"`
+	AnyOf         []*jsonSchema          `json:"anyOf,omitempty"`
+	Not           *jsonSchema            `json:"not,omitempty"`
+}
+
+type sinkTypeSummary struct {
+	Type         string `json:"type"`
+	Version      string `json:"version"`
+	EnvVarPrefix string `json:"env_var_prefix,omitempty"`
+}
+
+// schema returns the JSON Schema for the firehose configs (FirehoseConfig
+// in swagger) when using this sink type. Sink definitions describe the
+// latest firehose version only, so version must be the latest version.
+func (sd sinkDefinition) schema(version string) *jsonSchema {
+	minReplicas := 1
+
+	envVars := sd.envVarsSchema()
+	sinkType := &jsonSchema{Type: "string", Pattern: sinkTypePattern(sd.Type)}
+
+	schema := &jsonSchema{
+		Schema: jsonSchemaDraft,
+		ID:     fmt.Sprintf("/api/sinkTypes/%s/schema", sd.Type),
+		Title:  fmt.Sprintf("Firehose %s configs (firehose %s)", sd.Type, version),
+		Type:   "object",
+		// keep in sync with firehoseConfigs.validate()
+		Required: []string{"bootstrap_servers", "topic_name", "consumer_group_id"},
+		// sink type can be given using either of sink_type and SINK_TYPE
+		// env-var, and must match if both are given.
+		AnyOf: []*jsonSchema{
+			{Required: []string{"sink_type"}},
+			{
+				Required:   []string{"env_vars"},
+				Properties: map[string]*jsonSchema{"env_vars": {Required: []string{envSinkType}}},
+			},
+		},
+		Properties: map[string]*jsonSchema{
+			"version":                  {Type: "string", Default: version, ReadOnly: true, Description: "Firehose version to deploy."},
+			"image":                    {Type: "string", ReadOnly: true},
+			"replicas":                 {Type: "integer", Minimum: &minReplicas, Default: 1},
+			"sink_type":                sinkType,
+			"stop_date":                {Type: "string", Format: "date-time"},
+			"namespace":                {Type: "string", ReadOnly: true},
+			"topic_name":               {Type: "string", Description: "Kafka topic to consume from."},
+			"stream_name":              {Type: "string", Description: "Name of the stream the topic belongs to."},
+			"consumer_group_id":        {Type: "string", Description: "Kafka consumer group ID."},
+			"bootstrap_servers":        {Type: "string", Description: "Comma separated list of kafka brokers."},
+			"input_schema_proto_class": {Type: "string", Description: "Proto class of the input messages."},
+			"env_vars":                 envVars,
+		},
+	}
+
+	if len(envVars.Required) > 0 {
+		schema.Required = append(schema.Required, "env_vars")
+	}
+	return schema
+}
+
+func (sd sinkDefinition) envVarsSchema() *jsonSchema {
+	schema := &jsonSchema{
+		Type:        "object",
+		Description: fmt.Sprintf("Environment variables for the %s sink.", sd.Type),
+		Properties: map[string]*jsonSchema{
+			envSinkType: {Type: "string", Pattern: sinkTypePattern(sd.Type)},
+		},
+	}
+
+	var declared []string
+	for _, v := range sd.Variables {
+		declared = append(declared, v.Name)
+		if v.Required && v.Default == "" {
+			schema.Required = append(schema.Required, v.Name)
+		}
+		schema.Properties[v.Name] = v.schema()
+	}
+
+	// variables having the sink prefix must be one of the declared ones.
+	if sd.Prefix != "" {
+		schema.PropertyNames = &jsonSchema{
+			AnyOf: []*jsonSchema{
+				{Enum: declared},
+				{Not: &jsonSchema{Pattern: "^" + regexp.QuoteMeta(sd.Prefix)}},
+			},
+		}
+	}
+
+	return schema
+}
+
+// schema returns the schema for the env-var. All values are strings in
+// env_vars, so the type is expressed as a pattern.
+func (sv sinkVariable) schema() *jsonSchema {
+	schema := &jsonSchema{
+		Type:        "string",
+		Description: sv.Description,
+		Enum:        sv.Enum,
+	}
+
+	if sv.Default != "" {
+		schema.Default = sv.Default
+	}
+
+	switch sv.Type {
+	case varTypeInteger:
+		schema.Pattern = "^-?[0-9]+$"
+	case varTypeBoolean:
+		schema.Enum = []string{"true", "false"}
+	}
+
+	return schema
+}
+
+// sinkTypePattern returns the pattern matching the sink type the way
+// firehoseConfigs.validate() does: ignoring case and surrounding spaces.
+func sinkTypePattern(sinkType string) string {
+	var sb strings.Builder
+	sb.WriteString(`^\s*`)
+	for _, r := range sinkType {
+		if lower, upper := unicode.ToLower(r), unicode.ToUpper(r); lower != upper {
+			fmt.Fprintf(&sb, "[%c%c]", upper, lower)
+		} else {
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString(`\s*$`)
+	return sb.String()
+}
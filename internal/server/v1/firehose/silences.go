@@ -0,0 +1,513 @@
+package firehose
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"go.uber.org/zap"
+
+	"github.com/odpf/dex/internal/server/reqctx"
+	"github.com/odpf/dex/internal/server/utils"
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	pathParamSilenceID = "silenceID"
+
+	maxSilenceDuration    = 7 * 24 * time.Hour
+	silenceExpiryInterval = time.Minute
+)
+
+// alertSilence mutes the alert rules of a firehose until it expires. Siren
+// does not support silences, so the rules are disabled for the duration of
+// the silence and enabled again once it expires.
+type alertSilence struct {
+	ID             string    `json:"id"`
+	Templates      []string  `json:"templates"`
+	Reason         string    `json:"reason"`
+	CreatedBy      string    `json:"created_by"`
+	CreatedByEmail string    `json:"created_by_email"`
+	CreatedAt      time.Time `json:"created_at"`
+	ExpiresAt      time.Time `json:"expires_at"`
+
+	// RuleVersions has the update time of each silenced rule as of the
+	// silence. Rules updated since, by the user or otherwise, are left as
+	// they are when the silence ends.
+	RuleVersions map[string]time.Time `json:"rule_versions,omitempty"`
+}
+
+type createSilenceRequest struct {
+	Duration string `json:"duration"`
+	Reason   string `json:"reason"`
+
+	// Templates selects the rules to be silenced. All the enabled rules
+	// are silenced if empty.
+	Templates []string `json:"templates"`
+}
+
+func (req createSilenceRequest) parseDuration() (time.Duration, error) {
+	d, err := time.ParseDuration(req.Duration)
+	if err != nil || d <= 0 || d > maxSilenceDuration {
+		return 0, errors.ErrInvalid.
+			WithMsgf("duration must be a positive duration not longer than %s", maxSilenceDuration).
+			WithCausef("invalid duration '%s'", req.Duration)
+	}
+	return d, nil
+}
+
+func handleCreateAlertSilence(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+
+		target, err := getAlertTarget(r, client, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		var req createSilenceRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			utils.WriteErr(w, errors.ErrInvalid.
+				WithMsgf("request json body is not valid").
+				WithCausef(err.Error()))
+			return
+		}
+
+		duration, err := req.parseDuration()
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		} else if strings.TrimSpace(req.Reason) == "" {
+			utils.WriteErr(w, errors.ErrInvalid.WithMsgf("reason must be specified"))
+			return
+		} else if target.firehoseDef.State.State == stateStopped {
+			utils.WriteErr(w, errors.ErrInvalid.WithMsgf("alerts of a stopped firehose are already disabled"))
+			return
+		}
+
+		policy, err := svc.GetAlertPolicy(ctx, target.prj.GetSlug(), target.name)
+		if err != nil && !errors.Is(err, errors.ErrNotFound) {
+			utils.WriteErr(w, err)
+			return
+		} else if policy == nil {
+			policy = &alertsv1.Policy{Resource: target.name}
+		}
+
+		templates, err := silenceRules(policy, req.Templates)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		silenced, err := svc.UpsertAlertPolicy(ctx, target.prj.GetSlug(), *policy)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		ruleVersions := map[string]time.Time{}
+		for _, rule := range silenced.Rules {
+			if findInArray(templates, rule.Template) {
+				ruleVersions[rule.Template] = rule.UpdatedAt
+			}
+		}
+
+		rCtx := reqctx.From(ctx)
+		now := time.Now().UTC()
+		silence := alertSilence{
+			ID:             randomID(),
+			Templates:      templates,
+			Reason:         req.Reason,
+			CreatedBy:      rCtx.UserID,
+			CreatedByEmail: rCtx.UserEmail,
+			CreatedAt:      now,
+			ExpiresAt:      now.Add(duration),
+			RuleVersions:   ruleVersions,
+		}
+
+		err = updateAlertSilences(ctx, client, target.prj, target.firehoseDef.URN, func(silences []alertSilence) []alertSilence {
+			return append(silences, silence)
+		})
+		if err != nil {
+			if restoreErr := restoreAlertsForResource(ctx, target.firehoseDef, svc, target.prj, templates); restoreErr != nil {
+				err = errors.ErrInternal.
+					WithMsgf("saving the silence failed and re-enabling the silenced rules failed").
+					WithCausef("%s; %s", err, restoreErr)
+			}
+			utils.WriteErr(w, err)
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusCreated, silence)
+	}
+}
+
+func handleListAlertSilences(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		firehoseDef, err := getFirehoseResource(r.Context(), client, prj, mux.Vars(r)[pathParamURN])
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		silences, err := firehoseDef.getLabels().alertSilences()
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		// expired silences are removed in the background, and might be
+		// around for a while.
+		active := []alertSilence{}
+		for _, s := range silences {
+			if time.Now().Before(s.ExpiresAt) {
+				active = append(active, s)
+			}
+		}
+
+		utils.WriteJSON(w, http.StatusOK, listResponse[alertSilence]{Items: active})
+	}
+}
+
+func handleDeleteAlertSilence(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		pathVars := mux.Vars(r)
+
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		res, err := getResource(ctx, client, prj, pathVars[pathParamURN])
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		id := pathVars[pathParamSilenceID]
+		found, err := endAlertSilences(ctx, client, svc, prj, res, func(s alertSilence) bool {
+			return s.ID == id
+		})
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		} else if !found {
+			utils.WriteErr(w, errors.ErrNotFound.WithMsgf("no alert silence found with id '%s'", id))
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusNoContent, nil)
+	}
+}
+
+// silenceRules disables the enabled rules of the policy selected by the
+// templates and returns the templates of the disabled rules.
+func silenceRules(policy *alertsv1.Policy, selector []string) ([]string, error) {
+	var templates []string
+	for _, t := range selector {
+		found := false
+		for _, rule := range policy.Rules {
+			found = found || rule.Template == t
+		}
+		if !found {
+			return nil, errors.ErrInvalid.WithMsgf("no Alert Rule found for template '%s'", t)
+		}
+	}
+
+	for i, rule := range policy.Rules {
+		if rule.Enabled && (len(selector) == 0 || findInArray(selector, rule.Template)) {
+			policy.Rules[i].Enabled = false
+			templates = append(templates, rule.Template)
+		}
+	}
+
+	if len(templates) == 0 {
+		return nil, errors.ErrInvalid.WithMsgf("there are no enabled alert rules to silence")
+	}
+	return templates, nil
+}
+
+// endAlertSilences ends the silences of the firehose selected by the
+// predicate and re-enables the silenced rules that are not held by the
+// remaining silences and were not updated since silenced. Rules of
+// stopped firehoses are added to the alert snapshot instead, to be
+// enabled on start. Returns false if none of the silences were selected.
+func endAlertSilences(ctx context.Context, client entropyv1beta1.ResourceServiceClient, svc *alertsv1.Service,
+	prj *shieldv1beta1.Project, res *entropyv1beta1.Resource, selected func(alertSilence) bool,
+) (bool, error) {
+	firehoseDef, err := mapResourceToFirehose(res, false)
+	if err != nil {
+		return false, err
+	}
+
+	labels := firehoseDef.getLabels()
+	silences, err := labels.alertSilences()
+	if err != nil {
+		return false, err
+	}
+
+	var ending, remaining []alertSilence
+	for _, s := range silences {
+		if selected(s) {
+			ending = append(ending, s)
+		} else {
+			remaining = append(remaining, s)
+		}
+	}
+	if len(ending) == 0 {
+		return false, nil
+	}
+
+	templates, err := silencedRulesToRestore(ctx, svc, prj, firehoseDef, ending, remaining)
+	if err != nil {
+		return false, err
+	}
+
+	if firehoseDef.State.State == stateStopped {
+		snapshot := labels.alertSnapshot()
+		for _, t := range templates {
+			if !findInArray(snapshot, t) {
+				snapshot = append(snapshot, t)
+			}
+		}
+		labels.setAlertSnapshot(snapshot)
+	} else if err := restoreAlertsForResource(ctx, firehoseDef, svc, prj, templates); err != nil {
+		return false, err
+	}
+
+	if err := labels.setAlertSilences(remaining); err != nil {
+		return false, err
+	}
+	return true, updateResourceLabels(ctx, client, res, labels)
+}
+
+// silencedRulesToRestore returns the templates of the rules silenced by
+// the ending silences that are still disabled and untouched since.
+func silencedRulesToRestore(ctx context.Context, svc *alertsv1.Service, prj *shieldv1beta1.Project,
+	firehoseDef *firehoseDefinition, ending, remaining []alertSilence,
+) ([]string, error) {
+	name, err := getFirehoseReleaseName(firehoseDef)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := svc.GetAlertPolicy(ctx, prj.GetSlug(), name)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return restorableTemplates(policy.Rules, ending, remaining), nil
+}
+
+func restorableTemplates(rules []alertsv1.Rule, ending, remaining []alertSilence) []string {
+	var templates []string
+	for _, s := range ending {
+		for _, t := range s.Templates {
+			if findInArray(templates, t) || heldBySilences(remaining, t) {
+				continue
+			}
+
+			for _, rule := range rules {
+				if rule.Template != t || rule.Enabled {
+					continue
+				}
+
+				silencedAt, found := s.RuleVersions[t]
+				if !found || rule.UpdatedAt.Equal(silencedAt) {
+					templates = append(templates, t)
+				}
+			}
+		}
+	}
+	return templates
+}
+
+func heldBySilences(silences []alertSilence, template string) bool {
+	for _, s := range silences {
+		if findInArray(s.Templates, template) {
+			return true
+		}
+	}
+	return false
+}
+
+// updateAlertSilences replaces the silences of the firehose with the ones
+// returned by fn.
+func updateAlertSilences(ctx context.Context, client entropyv1beta1.ResourceServiceClient, prj *shieldv1beta1.Project,
+	urn string, fn func([]alertSilence) []alertSilence,
+) error {
+	res, err := getResource(ctx, client, prj, urn)
+	if err != nil {
+		return err
+	}
+
+	firehoseDef, err := mapResourceToFirehose(res, false)
+	if err != nil {
+		return err
+	}
+
+	labels := firehoseDef.getLabels()
+	silences, err := labels.alertSilences()
+	if err != nil {
+		return err
+	}
+
+	if err := labels.setAlertSilences(fn(silences)); err != nil {
+		return err
+	}
+	return updateResourceLabels(ctx, client, res, labels)
+}
+
+// updateResourceLabels applies the changes made to the labels read from
+// res onto the latest labels of the resource. The latest spec of the
+// resource is retained. Entropy has no conditional updates, so the
+// resource is read again right before the write and the changes are
+// merged with the concurrent ones. Returns ErrConflict if a changed label
+// was also changed concurrently. An update racing the final read can
+// still be overwritten.
+func updateResourceLabels(ctx context.Context, client entropyv1beta1.ResourceServiceClient, res *entropyv1beta1.Resource, labels firehoseLabels) error {
+	labelMap, err := labels.toMap()
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetResource(ctx, &entropyv1beta1.GetResourceRequest{Urn: res.GetUrn()})
+	if err != nil {
+		return err
+	}
+
+	latest := resp.GetResource()
+	merged, ok := mergeLabels(res.GetLabels(), labelMap, latest.GetLabels())
+	if !ok {
+		return errors.ErrConflict.
+			WithMsgf("firehose was modified concurrently, try again").
+			WithCausef("labels of resource '%s' updated since it was read", res.GetUrn())
+	}
+
+	_, err = client.UpdateResource(ctx, &entropyv1beta1.UpdateResourceRequest{
+		Urn:     latest.GetUrn(),
+		Labels:  merged,
+		NewSpec: latest.GetSpec(),
+	})
+	return err
+}
+
+// mergeLabels applies the changes from base to ours onto theirs. Returns
+// false if a label is changed differently in both.
+func mergeLabels(base, ours, theirs map[string]string) (map[string]string, bool) {
+	merged := map[string]string{}
+	for k, v := range theirs {
+		merged[k] = v
+	}
+
+	keys := map[string]bool{}
+	for k := range base {
+		keys[k] = true
+	}
+	for k := range ours {
+		keys[k] = true
+	}
+
+	for k := range keys {
+		b, inBase := base[k]
+		o, inOurs := ours[k]
+		if inBase == inOurs && b == o {
+			continue
+		}
+
+		t, inTheirs := theirs[k]
+		changedByThem := inTheirs != inBase || t != b
+		if changedByThem && (inTheirs != inOurs || t != o) {
+			return nil, false
+		}
+
+		if inOurs {
+			merged[k] = o
+		} else {
+			delete(merged, k)
+		}
+	}
+	return merged, true
+}
+
+// ExpireAlertSilences periodically ends the expired alert silences of all
+// the firehoses, until the context is cancelled. Ending a silence is
+// idempotent: replicas running this concurrently find the rules already
+// enabled and make the same label change, which updateResourceLabels merges
+// without a conflict.
+func ExpireAlertSilences(ctx context.Context, client entropyv1beta1.ResourceServiceClient, svc *alertsv1.Service,
+	logger *zap.Logger,
+) {
+	ticker := time.NewTicker(silenceExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-ticker.C:
+			// failures are retried on the next tick.
+			expireAlertSilences(ctx, client, svc, logger, time.Now())
+		}
+	}
+}
+
+func expireAlertSilences(ctx context.Context, client entropyv1beta1.ResourceServiceClient, svc *alertsv1.Service,
+	logger *zap.Logger, now time.Time,
+) {
+	resp, err := client.ListResources(ctx, &entropyv1beta1.ListResourcesRequest{Kind: kindFirehose})
+	if err != nil {
+		logger.Error("failed to list firehoses for silence expiry", zap.Error(err))
+		return
+	}
+
+	for _, res := range resp.GetResources() {
+		labels, err := toFirehoseLabels(res.GetLabels())
+		if err != nil || labels.AlertSilences == "" {
+			continue
+		}
+
+		// listed resource might be stale by now, since silences of the
+		// other firehoses were being ended.
+		urn := res.GetUrn()
+		prj := &shieldv1beta1.Project{Slug: res.GetProject()}
+		res, err = getResource(ctx, client, prj, urn)
+		if err == nil {
+			_, err = endAlertSilences(ctx, client, svc, prj, res, func(s alertSilence) bool {
+				return !now.Before(s.ExpiresAt)
+			})
+		}
+
+		if err != nil {
+			logger.Error("failed to expire alert silences",
+				zap.String("urn", urn), zap.Error(err))
+		}
+	}
+}
+
+func randomID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
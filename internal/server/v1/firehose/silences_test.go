@@ -0,0 +1,179 @@
+package firehose
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func Test_silenceRules(t *testing.T) {
+	t.Parallel()
+
+	newPolicy := func() *alertsv1.Policy {
+		return &alertsv1.Policy{Rules: []alertsv1.Rule{
+			{Template: "lag", Enabled: true},
+			{Template: "errors", Enabled: true},
+			{Template: "restarts", Enabled: false},
+		}}
+	}
+
+	t.Run("AllEnabled", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newPolicy()
+		templates, err := silenceRules(policy, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"lag", "errors"}, templates)
+		for _, rule := range policy.Rules {
+			assert.False(t, rule.Enabled)
+		}
+	})
+
+	t.Run("Selected", func(t *testing.T) {
+		t.Parallel()
+
+		policy := newPolicy()
+		templates, err := silenceRules(policy, []string{"errors", "restarts"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"errors"}, templates)
+		assert.True(t, policy.Rules[0].Enabled)
+		assert.False(t, policy.Rules[1].Enabled)
+	})
+
+	t.Run("UnknownTemplate", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := silenceRules(newPolicy(), []string{"unknown"})
+		assert.Error(t, err)
+	})
+
+	t.Run("NothingEnabled", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := silenceRules(newPolicy(), []string{"restarts"})
+		assert.Error(t, err)
+	})
+}
+
+func Test_firehoseLabels_alertSilences(t *testing.T) {
+	t.Parallel()
+
+	expiresAt := time.Date(2022, 6, 23, 16, 0, 0, 0, time.UTC)
+	silences := []alertSilence{
+		{ID: "a1", Templates: []string{"lag"}, Reason: "maintenance", ExpiresAt: expiresAt},
+	}
+
+	var labels firehoseLabels
+	require.NoError(t, labels.setAlertSilences(silences))
+
+	got, err := labels.alertSilences()
+	require.NoError(t, err)
+	assert.Equal(t, silences, got)
+	assert.True(t, heldBySilences(got, "lag"))
+	assert.False(t, heldBySilences(got, "errors"))
+
+	require.NoError(t, labels.setAlertSilences(nil))
+	assert.Empty(t, labels.AlertSilences)
+}
+
+func Test_restorableTemplates(t *testing.T) {
+	t.Parallel()
+
+	silencedAt := time.Date(2022, 6, 23, 16, 0, 0, 0, time.UTC)
+	rules := []alertsv1.Rule{
+		{Template: "lag", UpdatedAt: silencedAt},
+		// disabled by the user while silenced.
+		{Template: "errors", UpdatedAt: silencedAt.Add(time.Hour)},
+		// enabled by the user while silenced.
+		{Template: "restarts", Enabled: true, UpdatedAt: silencedAt.Add(time.Hour)},
+		{Template: "throughput", UpdatedAt: silencedAt},
+	}
+
+	ending := []alertSilence{{
+		Templates: []string{"lag", "errors", "restarts", "throughput"},
+		RuleVersions: map[string]time.Time{
+			"lag": silencedAt, "errors": silencedAt, "restarts": silencedAt, "throughput": silencedAt,
+		},
+	}}
+	remaining := []alertSilence{{Templates: []string{"throughput"}}}
+
+	assert.Equal(t, []string{"lag"}, restorableTemplates(rules, ending, remaining))
+}
+
+type fakeLabelsClient struct {
+	fakeResourceClient
+	updates []*entropyv1beta1.UpdateResourceRequest
+}
+
+func (f *fakeLabelsClient) UpdateResource(_ context.Context, in *entropyv1beta1.UpdateResourceRequest, _ ...grpc.CallOption) (*entropyv1beta1.UpdateResourceResponse, error) {
+	f.updates = append(f.updates, in)
+	return &entropyv1beta1.UpdateResourceResponse{}, nil
+}
+
+func Test_updateResourceLabels(t *testing.T) {
+	t.Parallel()
+
+	staleLabels, err := firehoseLabels{Title: "fh-1", AlertSilences: "[]"}.toMap()
+	require.NoError(t, err)
+	latestLabels, err := firehoseLabels{Title: "fh-1", AlertSilences: "[]", Description: "updated"}.toMap()
+	require.NoError(t, err)
+	wantLabels, err := firehoseLabels{Title: "fh-1", Description: "updated"}.toMap()
+	require.NoError(t, err)
+
+	readAt := time.Date(2022, 6, 23, 16, 0, 0, 0, time.UTC)
+	stale := &entropyv1beta1.Resource{Urn: "fh-1", Labels: staleLabels, UpdatedAt: timestamppb.New(readAt)}
+	latest := &entropyv1beta1.Resource{
+		Urn:       "fh-1",
+		Labels:    latestLabels,
+		UpdatedAt: timestamppb.New(readAt.Add(time.Second)),
+		Spec:      &entropyv1beta1.ResourceSpec{},
+	}
+	client := &fakeLabelsClient{
+		fakeResourceClient: fakeResourceClient{
+			resources: map[string]*entropyv1beta1.Resource{"fh-1": latest},
+		},
+	}
+
+	// concurrent change of other labels is retained along with the spec.
+	require.NoError(t, updateResourceLabels(context.Background(), client, stale, firehoseLabels{Title: "fh-1"}))
+	require.Len(t, client.updates, 1)
+	assert.Same(t, latest.Spec, client.updates[0].NewSpec)
+	assert.Equal(t, wantLabels, client.updates[0].Labels)
+
+	// concurrent change of the same label is a conflict.
+	latest.Labels["title"] = "renamed"
+	err = updateResourceLabels(context.Background(), client, stale, firehoseLabels{Title: "fh-2"})
+	assert.ErrorIs(t, err, errors.ErrConflict)
+	assert.Len(t, client.updates, 1)
+}
+
+func Test_mergeLabels(t *testing.T) {
+	t.Parallel()
+
+	base := map[string]string{"a": "1", "b": "2", "c": "3"}
+	ours := map[string]string{"a": "10", "c": "3", "d": "4"}
+
+	merged, ok := mergeLabels(base, ours, map[string]string{"a": "1", "b": "2", "c": "30"})
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"a": "10", "c": "30", "d": "4"}, merged)
+
+	// same change on both sides is not a conflict.
+	merged, ok = mergeLabels(base, ours, map[string]string{"a": "10", "c": "3"})
+	require.True(t, ok)
+	assert.Equal(t, map[string]string{"a": "10", "c": "3", "d": "4"}, merged)
+
+	_, ok = mergeLabels(base, ours, map[string]string{"a": "11", "b": "2", "c": "3"})
+	assert.False(t, ok)
+
+	_, ok = mergeLabels(base, ours, map[string]string{"a": "1", "b": "2", "c": "3", "d": "5"})
+	assert.False(t, ok)
+}
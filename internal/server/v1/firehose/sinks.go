@@ -0,0 +1,376 @@
+package firehose
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	envSinkType = "SINK_TYPE"
+
+	varTypeString  = "string"
+	varTypeInteger = "integer"
+	varTypeBoolean = "boolean"
+)
+
+type sinkDefinition struct {
+	Type string
+	// Prefix is the env-var name prefix owned by the sink. Any variable with
+	// this prefix that is not declared in Variables is rejected, so that
+	// typos are caught before the firehose is deployed.
+	Prefix    string
+	Variables []sinkVariable
+}
+
+type sinkVariable struct {
+	Name        string
+	Type        string
+	Required    bool
+	Default     string
+	Enum        []string
+	Description string
+}
+
+// sinkDefinitions declares the env-vars understood by each of the firehose
+// sink types. Refer https://odpf.github.io/firehose/reference/configuration
+var sinkDefinitions = []sinkDefinition{
+	{
+		Type: "LOG",
+	},
+	{
+		Type:   "HTTP",
+		Prefix: "SINK_HTTP_",
+		Variables: []sinkVariable{
+			{Name: "SINK_HTTP_SERVICE_URL", Type: varTypeString, Required: true, Description: "HTTP endpoint of the service to which the data is pushed."},
+			{Name: "SINK_HTTP_REQUEST_METHOD", Type: varTypeString, Default: "put", Enum: []string{"put", "post", "patch", "delete"}, Description: "HTTP verb to be used for the requests."},
+			{Name: "SINK_HTTP_REQUEST_TIMEOUT_MS", Type: varTypeInteger, Default: "10000", Description: "Timeout for the HTTP requests in milliseconds."},
+			{Name: "SINK_HTTP_MAX_CONNECTIONS", Type: varTypeInteger, Default: "10", Description: "Maximum number of connections in the HTTP connection pool."},
+			{Name: "SINK_HTTP_RETRY_STATUS_CODE_RANGES", Type: varTypeString, Default: "400-600", Description: "Ranges of response status codes to be retried."},
+			{Name: "SINK_HTTP_REQUEST_LOG_STATUS_CODE_RANGES", Type: varTypeString, Default: "400-499", Description: "Ranges of response status codes for which the request is logged."},
+			{Name: "SINK_HTTP_DATA_FORMAT", Type: varTypeString, Default: "proto", Enum: []string{"proto", "json"}, Description: "Format of the request body."},
+			{Name: "SINK_HTTP_JSON_BODY_TEMPLATE", Type: varTypeString, Description: "Template for the JSON request body."},
+			{Name: "SINK_HTTP_HEADERS", Type: varTypeString, Description: "Comma separated list of headers (key:value) to be sent with each request."},
+			{Name: "SINK_HTTP_PARAMETER_SOURCE", Type: varTypeString, Default: "disabled", Enum: []string{"key", "message", "disabled"}, Description: "Source of the parameterised request values."},
+			{Name: "SINK_HTTP_PARAMETER_PLACEMENT", Type: varTypeString, Enum: []string{"query", "header"}, Description: "Placement of the parameterised request values."},
+			{Name: "SINK_HTTP_PARAMETER_SCHEMA_PROTO_CLASS", Type: varTypeString, Description: "Proto class used to read the parameterised request values."},
+			{Name: "SINK_HTTP_DELETE_BODY_ENABLE", Type: varTypeBoolean, Default: "true", Description: "Send request body with DELETE requests."},
+			{Name: "SINK_HTTP_OAUTH2_ENABLE", Type: varTypeBoolean, Default: "false", Description: "Use OAuth2 client-credentials to authenticate requests."},
+			{Name: "SINK_HTTP_OAUTH2_ACCESS_TOKEN_URL", Type: varTypeString, Description: "OAuth2 token endpoint."},
+			{Name: "SINK_HTTP_OAUTH2_CLIENT_NAME", Type: varTypeString, Description: "OAuth2 client identifier."},
+			{Name: "SINK_HTTP_OAUTH2_CLIENT_SECRET", Type: varTypeString, Description: "OAuth2 client secret."},
+			{Name: "SINK_HTTP_OAUTH2_SCOPE", Type: varTypeString, Description: "OAuth2 scopes to request."},
+		},
+	},
+	{
+		Type:   "POSTGRES",
+		Prefix: "SINK_JDBC_",
+		Variables: []sinkVariable{
+			{Name: "SINK_JDBC_URL", Type: varTypeString, Required: true, Description: "JDBC URL of the PostgresDB instance."},
+			{Name: "SINK_JDBC_TABLE_NAME", Type: varTypeString, Required: true, Description: "Name of the table to write to."},
+			{Name: "SINK_JDBC_USERNAME", Type: varTypeString, Required: true, Description: "Username for connecting to the database."},
+			{Name: "SINK_JDBC_PASSWORD", Type: varTypeString, Required: true, Description: "Password for connecting to the database."},
+			{Name: "SINK_JDBC_UNIQUE_KEYS", Type: varTypeString, Description: "Comma separated list of columns forming the unique key for upserts."},
+			{Name: "SINK_JDBC_CONNECTION_POOL_TIMEOUT_MS", Type: varTypeInteger, Default: "1000", Description: "Timeout for acquiring a connection from the pool."},
+			{Name: "SINK_JDBC_CONNECTION_POOL_IDLE_TIMEOUT_MS", Type: varTypeInteger, Default: "60000", Description: "Idle timeout of pooled connections."},
+			{Name: "SINK_JDBC_CONNECTION_POOL_MIN_IDLE", Type: varTypeInteger, Default: "0", Description: "Minimum number of idle connections in the pool."},
+			{Name: "SINK_JDBC_CONNECTION_POOL_MAX_SIZE", Type: varTypeInteger, Default: "10", Description: "Maximum number of connections in the pool."},
+		},
+	},
+	{
+		Type:   "INFLUXDB",
+		Prefix: "SINK_INFLUX_",
+		Variables: []sinkVariable{
+			{Name: "SINK_INFLUX_URL", Type: varTypeString, Required: true, Description: "URL of the InfluxDB instance."},
+			{Name: "SINK_INFLUX_USERNAME", Type: varTypeString, Required: true, Description: "Username for connecting to InfluxDB."},
+			{Name: "SINK_INFLUX_PASSWORD", Type: varTypeString, Required: true, Description: "Password for connecting to InfluxDB."},
+			{Name: "SINK_INFLUX_DB_NAME", Type: varTypeString, Required: true, Description: "Name of the database to write to."},
+			{Name: "SINK_INFLUX_MEASUREMENT_NAME", Type: varTypeString, Required: true, Description: "Name of the measurement to write to."},
+			{Name: "SINK_INFLUX_RETENTION_POLICY", Type: varTypeString, Default: "autogen", Description: "Retention policy of the database."},
+			{Name: "SINK_INFLUX_PROTO_EVENT_TIMESTAMP_INDEX", Type: varTypeInteger, Required: true, Description: "Proto index of the event timestamp field."},
+			{Name: "SINK_INFLUX_FIELD_NAME_PROTO_INDEX_MAPPING", Type: varTypeString, Required: true, Description: "JSON mapping of proto index to field names."},
+			{Name: "SINK_INFLUX_TAG_NAME_PROTO_INDEX_MAPPING", Type: varTypeString, Description: "JSON mapping of proto index to tag names."},
+		},
+	},
+	{
+		Type:   "ELASTIC",
+		Prefix: "SINK_ES_",
+		Variables: []sinkVariable{
+			{Name: "SINK_ES_CONNECTION_URLS", Type: varTypeString, Required: true, Description: "Comma separated list of Elasticsearch host:port pairs."},
+			{Name: "SINK_ES_INDEX_NAME", Type: varTypeString, Required: true, Description: "Name of the index to write to."},
+			{Name: "SINK_ES_TYPE_NAME", Type: varTypeString, Description: "Type name of the documents."},
+			{Name: "SINK_ES_ID_FIELD", Type: varTypeString, Description: "Field used as the document ID."},
+			{Name: "SINK_ES_ROUTING_KEY_NAME", Type: varTypeString, Description: "Field used as the routing key."},
+			{Name: "SINK_ES_INPUT_MESSAGE_TYPE", Type: varTypeString, Default: "JSON", Enum: []string{"JSON", "PROTOBUF"}, Description: "Format of the input messages."},
+			{Name: "SINK_ES_MODE_UPDATE_ONLY_ENABLE", Type: varTypeBoolean, Default: "false", Description: "Only update existing documents."},
+			{Name: "SINK_ES_PRESERVE_PROTO_FIELD_NAMES_ENABLE", Type: varTypeBoolean, Default: "true", Description: "Use proto field names instead of camel-cased names."},
+			{Name: "SINK_ES_REQUEST_TIMEOUT_MS", Type: varTypeInteger, Default: "60000", Description: "Timeout for requests in milliseconds."},
+			{Name: "SINK_ES_SHARDS_ACTIVE_WAIT_COUNT", Type: varTypeInteger, Default: "1", Description: "Number of active shard copies to wait for."},
+			{Name: "SINK_ES_RETRY_STATUS_CODE_BLACKLIST", Type: varTypeString, Default: "404", Description: "Comma separated list of status codes that are not retried."},
+		},
+	},
+	{
+		Type:   "REDIS",
+		Prefix: "SINK_REDIS_",
+		Variables: []sinkVariable{
+			{Name: "SINK_REDIS_URLS", Type: varTypeString, Required: true, Description: "Comma separated list of Redis host:port pairs."},
+			{Name: "SINK_REDIS_KEY_TEMPLATE", Type: varTypeString, Required: true, Description: "Template for the Redis keys."},
+			{Name: "SINK_REDIS_DATA_TYPE", Type: varTypeString, Default: "HASHSET", Enum: []string{"LIST", "HASHSET", "KEYVALUE"}, Description: "Redis data type to write."},
+			{Name: "SINK_REDIS_LIST_DATA_PROTO_INDEX", Type: varTypeInteger, Description: "Proto index of the field pushed to the list."},
+			{Name: "SINK_REDIS_KEY_VALUE_DATA_PROTO_INDEX", Type: varTypeInteger, Description: "Proto index of the field used as the value."},
+			{Name: "SINK_REDIS_HASHSET_FIELD_TO_COLUMN_MAPPING", Type: varTypeString, Description: "JSON mapping of proto fields to hash-set fields."},
+			{Name: "SINK_REDIS_TTL_TYPE", Type: varTypeString, Default: "DISABLE", Enum: []string{"DISABLE", "DURATION", "EXACT_TIME"}, Description: "Type of TTL applied to the keys."},
+			{Name: "SINK_REDIS_TTL_VALUE", Type: varTypeInteger, Default: "0", Description: "TTL value as per the TTL type."},
+			{Name: "SINK_REDIS_DEPLOYMENT_TYPE", Type: varTypeString, Default: "Standalone", Enum: []string{"Standalone", "Cluster"}, Description: "Deployment type of the Redis instance."},
+		},
+	},
+	{
+		Type:   "GRPC",
+		Prefix: "SINK_GRPC_",
+		Variables: []sinkVariable{
+			{Name: "SINK_GRPC_SERVICE_HOST", Type: varTypeString, Required: true, Description: "Host of the gRPC service."},
+			{Name: "SINK_GRPC_SERVICE_PORT", Type: varTypeInteger, Required: true, Description: "Port of the gRPC service."},
+			{Name: "SINK_GRPC_METHOD_URL", Type: varTypeString, Required: true, Description: "Fully qualified gRPC method to invoke."},
+			{Name: "SINK_GRPC_RESPONSE_SCHEMA_PROTO_CLASS", Type: varTypeString, Required: true, Description: "Proto class of the gRPC response."},
+		},
+	},
+	{
+		Type:   "PROMETHEUS",
+		Prefix: "SINK_PROM_",
+		Variables: []sinkVariable{
+			{Name: "SINK_PROM_SERVICE_URL", Type: varTypeString, Required: true, Description: "Remote-write endpoint of the Prometheus compatible service."},
+			{Name: "SINK_PROM_REQUEST_TIMEOUT_MS", Type: varTypeInteger, Default: "10000", Description: "Timeout for the requests in milliseconds."},
+			{Name: "SINK_PROM_MAX_CONNECTIONS", Type: varTypeInteger, Default: "10", Description: "Maximum number of connections in the pool."},
+			{Name: "SINK_PROM_RETRY_STATUS_CODE_RANGES", Type: varTypeString, Default: "400-600", Description: "Ranges of response status codes to be retried."},
+			{Name: "SINK_PROM_REQUEST_LOG_STATUS_CODE_RANGES", Type: varTypeString, Default: "400-499", Description: "Ranges of response status codes for which the request is logged."},
+			{Name: "SINK_PROM_HEADERS", Type: varTypeString, Description: "Comma separated list of headers (key:value) to be sent with each request."},
+			{Name: "SINK_PROM_METRIC_NAME_PROTO_INDEX_MAPPING", Type: varTypeString, Required: true, Description: "JSON mapping of proto index to metric names."},
+			{Name: "SINK_PROM_LABEL_NAME_PROTO_INDEX_MAPPING", Type: varTypeString, Description: "JSON mapping of proto index to label names."},
+			{Name: "SINK_PROM_WITH_EVENT_TIMESTAMP", Type: varTypeBoolean, Default: "false", Description: "Use the event timestamp instead of ingestion time."},
+			{Name: "SINK_PROM_PROTO_EVENT_TIMESTAMP_INDEX", Type: varTypeInteger, Description: "Proto index of the event timestamp field."},
+		},
+	},
+	{
+		Type:   "BIGQUERY",
+		Prefix: "SINK_BIGQUERY_",
+		Variables: []sinkVariable{
+			{Name: "SINK_BIGQUERY_GOOGLE_CLOUD_PROJECT_ID", Type: varTypeString, Required: true, Description: "GCP project containing the dataset."},
+			{Name: "SINK_BIGQUERY_DATASET_NAME", Type: varTypeString, Required: true, Description: "Name of the dataset to write to."},
+			{Name: "SINK_BIGQUERY_TABLE_NAME", Type: varTypeString, Required: true, Description: "Name of the table to write to."},
+			{Name: "SINK_BIGQUERY_CREDENTIAL_PATH", Type: varTypeString, Description: "Path to the service-account credential file."},
+			{Name: "SINK_BIGQUERY_DATASET_LABELS", Type: varTypeString, Description: "Comma separated list of key=value labels for the dataset."},
+			{Name: "SINK_BIGQUERY_TABLE_LABELS", Type: varTypeString, Description: "Comma separated list of key=value labels for the table."},
+			{Name: "SINK_BIGQUERY_DATASET_LOCATION", Type: varTypeString, Default: "asia-southeast1", Description: "Location of the dataset."},
+			{Name: "SINK_BIGQUERY_TABLE_PARTITIONING_ENABLE", Type: varTypeBoolean, Default: "false", Description: "Create the table with time partitioning."},
+			{Name: "SINK_BIGQUERY_TABLE_PARTITION_KEY", Type: varTypeString, Description: "Field used for partitioning the table."},
+			{Name: "SINK_BIGQUERY_TABLE_PARTITION_EXPIRY_MS", Type: varTypeInteger, Default: "-1", Description: "Expiry of the table partitions in milliseconds."},
+			{Name: "SINK_BIGQUERY_TABLE_CLUSTERING_ENABLE", Type: varTypeBoolean, Default: "false", Description: "Create the table with clustering."},
+			{Name: "SINK_BIGQUERY_TABLE_CLUSTERING_KEYS", Type: varTypeString, Description: "Comma separated list of fields used for clustering."},
+			{Name: "SINK_BIGQUERY_ROW_INSERT_ID_ENABLE", Type: varTypeBoolean, Default: "true", Description: "Set insert-id for best-effort de-duplication."},
+			{Name: "SINK_BIGQUERY_METADATA_NAMESPACE", Type: varTypeString, Description: "Column name under which the kafka metadata is nested."},
+			{Name: "SINK_BIGQUERY_ADD_EVENT_TIMESTAMP_ENABLE", Type: varTypeBoolean, Default: "false", Description: "Add event timestamp column to the rows."},
+			{Name: "SINK_BIGQUERY_CLIENT_READ_TIMEOUT_MS", Type: varTypeInteger, Default: "-1", Description: "Read timeout of the BigQuery client."},
+			{Name: "SINK_BIGQUERY_CLIENT_CONNECT_TIMEOUT_MS", Type: varTypeInteger, Default: "-1", Description: "Connect timeout of the BigQuery client."},
+		},
+	},
+	{
+		Type:   "BLOB",
+		Prefix: "SINK_BLOB_",
+		Variables: []sinkVariable{
+			{Name: "SINK_BLOB_STORAGE_TYPE", Type: varTypeString, Default: "GCS", Enum: []string{"GCS"}, Description: "Type of the object storage."},
+			{Name: "SINK_BLOB_GCS_GOOGLE_CLOUD_PROJECT_ID", Type: varTypeString, Required: true, Description: "GCP project containing the bucket."},
+			{Name: "SINK_BLOB_GCS_BUCKET_NAME", Type: varTypeString, Required: true, Description: "Name of the bucket to write to."},
+			{Name: "SINK_BLOB_GCS_CREDENTIAL_PATH", Type: varTypeString, Description: "Path to the service-account credential file."},
+			{Name: "SINK_BLOB_OUTPUT_FILE_FORMAT", Type: varTypeString, Default: "PARQUET", Enum: []string{"PARQUET"}, Description: "Format of the output files."},
+			{Name: "SINK_BLOB_OUTPUT_KAFKA_METADATA_ENABLE", Type: varTypeBoolean, Default: "false", Description: "Add kafka metadata to the records."},
+			{Name: "SINK_BLOB_OUTPUT_KAFKA_METADATA_COLUMN_NAME", Type: varTypeString, Description: "Column name under which the kafka metadata is nested."},
+			{Name: "SINK_BLOB_LOCAL_DIRECTORY", Type: varTypeString, Default: "/tmp/firehose", Description: "Local directory for staging the files."},
+			{Name: "SINK_BLOB_LOCAL_FILE_ROTATION_DURATION_MS", Type: varTypeInteger, Default: "3600000", Description: "Duration after which local files are rotated."},
+			{Name: "SINK_BLOB_LOCAL_FILE_ROTATION_MAX_SIZE_BYTES", Type: varTypeInteger, Default: "268435456", Description: "Size after which local files are rotated."},
+			{Name: "SINK_BLOB_LOCAL_FILE_WRITER_PARQUET_BLOCK_SIZE", Type: varTypeInteger, Default: "134217728", Description: "Parquet block size."},
+			{Name: "SINK_BLOB_LOCAL_FILE_WRITER_PARQUET_PAGE_SIZE", Type: varTypeInteger, Default: "1048576", Description: "Parquet page size."},
+			{Name: "SINK_BLOB_FILE_PARTITION_PROTO_TIMESTAMP_FIELD_NAME", Type: varTypeString, Required: true, Description: "Timestamp field used for partitioning the files."},
+			{Name: "SINK_BLOB_FILE_PARTITION_PROTO_TIMESTAMP_TIMEZONE", Type: varTypeString, Default: "UTC", Description: "Timezone of the partitioning timestamp."},
+			{Name: "SINK_BLOB_FILE_PARTITION_TIME_GRANULARITY_TYPE", Type: varTypeString, Default: "day", Enum: []string{"hour", "day"}, Description: "Granularity of the time partitions."},
+			{Name: "SINK_BLOB_FILE_PARTITION_TIME_DATE_PREFIX", Type: varTypeString, Default: "dt=", Description: "Prefix for the date partition directories."},
+			{Name: "SINK_BLOB_FILE_PARTITION_TIME_HOUR_PREFIX", Type: varTypeString, Default: "hr=", Description: "Prefix for the hour partition directories."},
+		},
+	},
+}
+
+func getSinkDefinition(sinkType string) (*sinkDefinition, bool) {
+	for _, def := range sinkDefinitions {
+		if strings.EqualFold(def.Type, sinkType) {
+			return &def, true
+		}
+	}
+	return nil, false
+}
+
+func sinkTypeNames() []string {
+	var names []string
+	for _, def := range sinkDefinitions {
+		names = append(names, def.Type)
+	}
+	return names
+}
+
+// validate checks the configs against the definition of the sink type
+// and returns ErrInvalid with a FieldError for every problem found. The
+// sink-type is normalised into SINK_TYPE env-var since that is what the
+// firehose reads.
+func (fc *firehoseConfigs) validate() error {
+	return fc.validateUpdate(nil)
+}
+
+// validateUpdate is validate for an update of the current configs. Only
+// the env-vars changed by the update are checked against the sink type,
+// so that existing firehoses using variables missing in the sink
+// definitions can still be updated.
+func (fc *firehoseConfigs) validateUpdate(cur *firehoseConfigs) error {
+	if fc.EnvVars == nil {
+		fc.EnvVars = map[string]string{}
+	}
+
+	var fieldErrs []errors.FieldError
+	addErr := func(field, format string, args ...interface{}) {
+		fieldErrs = append(fieldErrs, errors.FieldError{
+			Field:  field,
+			Reason: fmt.Sprintf(format, args...),
+		})
+	}
+
+	requiredFields := map[string]string{
+		"configs.bootstrap_servers": fc.BootstrapServers,
+		"configs.topic_name":        fc.TopicName,
+		"configs.consumer_group_id": fc.ConsumerGroupID,
+	}
+	for field, val := range requiredFields {
+		if strings.TrimSpace(val) == "" {
+			addErr(field, "value must be specified")
+		}
+	}
+
+	sinkType := strings.ToUpper(strings.TrimSpace(fc.SinkType))
+	envType := strings.ToUpper(strings.TrimSpace(fc.EnvVars[envSinkType]))
+	if sinkType == "" {
+		sinkType = envType
+	} else if envType != "" && envType != sinkType {
+		addErr("configs.env_vars."+envSinkType, "value '%s' does not match sink_type '%s'", envType, sinkType)
+	}
+
+	sinkDef, found := getSinkDefinition(sinkType)
+	if sinkType == "" {
+		addErr("configs.sink_type", "value must be specified")
+	} else if !found {
+		addErr("configs.sink_type", "value must be one of %s", strings.Join(sinkTypeNames(), ", "))
+	} else {
+		fc.SinkType = sinkDef.Type
+		fc.EnvVars[envSinkType] = sinkDef.Type
+		var curEnv map[string]string
+		if cur != nil {
+			curEnv = cur.EnvVars
+			if curEnv == nil {
+				curEnv = map[string]string{}
+			}
+		}
+		fieldErrs = append(fieldErrs, sinkDef.validateEnv(fc.EnvVars, curEnv)...)
+	}
+
+	if len(fieldErrs) == 0 {
+		return nil
+	}
+
+	sort.Slice(fieldErrs, func(i, j int) bool {
+		return fieldErrs[i].Field < fieldErrs[j].Field
+	})
+	return errors.ErrInvalid.
+		WithMsgf("firehose configs are not valid").
+		WithDetails(fieldErrs)
+}
+
+// validateEnv checks the env-vars against the sink definition. If curEnv
+// is not nil, variables having the same value in curEnv are not checked.
+func (sd sinkDefinition) validateEnv(env, curEnv map[string]string) []errors.FieldError {
+	var fieldErrs []errors.FieldError
+
+	unchanged := func(name string) bool {
+		if curEnv == nil {
+			return false
+		}
+		curVal, curSet := curEnv[name]
+		val, isSet := env[name]
+		return curSet == isSet && curVal == val
+	}
+
+	declared := map[string]bool{}
+	for _, v := range sd.Variables {
+		declared[v.Name] = true
+		if unchanged(v.Name) {
+			continue
+		}
+
+		val, isSet := env[v.Name]
+		if !isSet || strings.TrimSpace(val) == "" {
+			if v.Required && v.Default == "" {
+				fieldErrs = append(fieldErrs, errors.FieldError{
+					Field:  "configs.env_vars." + v.Name,
+					Reason: fmt.Sprintf("variable is required for sink type %s", sd.Type),
+				})
+			}
+			continue
+		}
+
+		if reason := v.check(val); reason != "" {
+			fieldErrs = append(fieldErrs, errors.FieldError{
+				Field:  "configs.env_vars." + v.Name,
+				Reason: reason,
+			})
+		}
+	}
+
+	if sd.Prefix != "" {
+		for name := range env {
+			if strings.HasPrefix(name, sd.Prefix) && !declared[name] && !unchanged(name) {
+				fieldErrs = append(fieldErrs, errors.FieldError{
+					Field:  "configs.env_vars." + name,
+					Reason: fmt.Sprintf("unknown variable for sink type %s", sd.Type),
+				})
+			}
+		}
+	}
+
+	return fieldErrs
+}
+
+// check returns the reason the value is not valid for the variable. Returns
+// empty string if the value is valid.
+func (sv sinkVariable) check(val string) string {
+	val = strings.TrimSpace(val)
+
+	switch sv.Type {
+	case varTypeInteger:
+		if _, err := strconv.ParseInt(val, 10, 64); err != nil {
+			return fmt.Sprintf("value '%s' is not a valid integer", val)
+		}
+
+	case varTypeBoolean:
+		if _, err := strconv.ParseBool(val); err != nil {
+			return fmt.Sprintf("value '%s' is not a valid boolean", val)
+		}
+	}
+
+	if len(sv.Enum) > 0 {
+		for _, allowed := range sv.Enum {
+			if strings.EqualFold(allowed, val) {
+				return ""
+			}
+		}
+		return fmt.Sprintf("value must be one of %s", strings.Join(sv.Enum, ", "))
+	}
+
+	return ""
+}
@@ -0,0 +1,106 @@
+package firehose
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+func Test_firehoseConfigs_validate(t *testing.T) {
+	t.Parallel()
+
+	validConfigs := func() firehoseConfigs {
+		return firehoseConfigs{
+			SinkType:         "bigquery",
+			TopicName:        "booking-log",
+			ConsumerGroupID:  "booking-ingester-0001",
+			BootstrapServers: "localhost:9092",
+			EnvVars: map[string]string{
+				"SINK_BIGQUERY_GOOGLE_CLOUD_PROJECT_ID": "foo",
+				"SINK_BIGQUERY_DATASET_NAME":            "bar",
+				"SINK_BIGQUERY_TABLE_NAME":              "booking",
+				"SINK_BIGQUERY_ROW_INSERT_ID_ENABLE":    "false",
+			},
+		}
+	}
+
+	t.Run("Valid", func(t *testing.T) {
+		fc := validConfigs()
+		require.NoError(t, fc.validate())
+		assert.Equal(t, "BIGQUERY", fc.SinkType)
+		assert.Equal(t, "BIGQUERY", fc.EnvVars[envSinkType])
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		fc := validConfigs()
+		fc.TopicName = ""
+		fc.EnvVars["SINK_BIGQUERY_ROW_INSERT_ID_ENABLE"] = "maybe"
+		fc.EnvVars["SINK_BIGQUERY_TABLE_NAM"] = "booking"
+		delete(fc.EnvVars, "SINK_BIGQUERY_DATASET_NAME")
+
+		err := fc.validate()
+		require.ErrorIs(t, err, errors.ErrInvalid)
+
+		var fields []string
+		for _, fe := range err.(errors.Error).Details.([]errors.FieldError) {
+			fields = append(fields, fe.Field)
+		}
+		assert.Equal(t, []string{
+			"configs.env_vars.SINK_BIGQUERY_DATASET_NAME",
+			"configs.env_vars.SINK_BIGQUERY_ROW_INSERT_ID_ENABLE",
+			"configs.env_vars.SINK_BIGQUERY_TABLE_NAM",
+			"configs.topic_name",
+		}, fields)
+	})
+
+	t.Run("SinkTypeMismatch", func(t *testing.T) {
+		fc := validConfigs()
+		fc.EnvVars[envSinkType] = "LOG"
+		assert.ErrorIs(t, fc.validate(), errors.ErrInvalid)
+	})
+
+	t.Run("UnknownSinkType", func(t *testing.T) {
+		fc := validConfigs()
+		fc.SinkType = "KAFKA"
+		assert.ErrorIs(t, fc.validate(), errors.ErrInvalid)
+	})
+
+	t.Run("UpdateOfExistingConfigs", func(t *testing.T) {
+		// existing firehose uses a variable missing in the sink definition.
+		cur := validConfigs()
+		cur.EnvVars["SINK_BIGQUERY_UNLISTED_OPTION"] = "x"
+
+		fc := validConfigs()
+		fc.EnvVars["SINK_BIGQUERY_UNLISTED_OPTION"] = "x"
+		require.NoError(t, fc.validateUpdate(&cur))
+
+		fc = validConfigs()
+		fc.EnvVars["SINK_BIGQUERY_UNLISTED_OPTION"] = "y"
+		assert.ErrorIs(t, fc.validateUpdate(&cur), errors.ErrInvalid)
+	})
+}
+
+func Test_sinkDefinition_schema(t *testing.T) {
+	t.Parallel()
+
+	def, found := getSinkDefinition("BIGQUERY")
+	require.True(t, found)
+
+	schema := def.schema("0.3.0")
+	assert.Equal(t, "/api/sinkTypes/BIGQUERY/schema", schema.ID)
+	assert.NotContains(t, schema.Required, "sink_type")
+	require.Len(t, schema.AnyOf, 2)
+	assert.Equal(t, []string{"sink_type"}, schema.AnyOf[0].Required)
+	assert.Equal(t, []string{envSinkType}, schema.AnyOf[1].Properties["env_vars"].Required)
+
+	// validate() accepts the sink type ignoring case and surrounding spaces.
+	pattern := regexp.MustCompile(schema.Properties["env_vars"].Properties[envSinkType].Pattern)
+	for _, val := range []string{"BIGQUERY", " bigquery ", "BigQuery"} {
+		assert.True(t, pattern.MatchString(val), val)
+	}
+	assert.False(t, pattern.MatchString("LOG"))
+}
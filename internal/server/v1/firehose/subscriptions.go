@@ -0,0 +1,152 @@
+package firehose
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	entropyv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/entropy/v1beta1"
+
+	"github.com/odpf/dex/internal/server/utils"
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+	projectsv1 "github.com/odpf/dex/internal/server/v1/project"
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	pathParamSubscriptionID = "subscriptionID"
+
+	// subscriptionMatchLabel is the alert label carrying the release name
+	// of the firehose, bound to the rules via the 'name' variable.
+	subscriptionMatchLabel = "name"
+)
+
+type createSubscriptionRequest struct {
+	// URN of the subscription. Generated from the release name if empty.
+	URN       string                          `json:"urn"`
+	Receivers []alertsv1.SubscriptionReceiver `json:"receivers"`
+
+	// Match has the labels, other than the release name, the alerts must
+	// have to be routed to the receivers (e.g., severity).
+	Match map[string]string `json:"match"`
+}
+
+func (req createSubscriptionRequest) toSubscription(name string) (alertsv1.Subscription, error) {
+	if len(req.Receivers) == 0 {
+		return alertsv1.Subscription{}, errors.ErrInvalid.WithMsgf("at least one receiver must be specified")
+	}
+
+	match := map[string]string{}
+	for k, v := range req.Match {
+		match[k] = v
+	}
+	if v, found := match[subscriptionMatchLabel]; found && v != name {
+		return alertsv1.Subscription{}, errors.ErrInvalid.
+			WithMsgf("match label '%s' must be the release name of the firehose", subscriptionMatchLabel)
+	}
+	match[subscriptionMatchLabel] = name
+
+	urn := strings.TrimSpace(req.URN)
+	if urn == "" {
+		urn = fmt.Sprintf("%s-%s", name, randomID())
+	}
+
+	return alertsv1.Subscription{
+		URN:       urn,
+		Receivers: req.Receivers,
+		Match:     match,
+	}, nil
+}
+
+func handleListAlertReceivers(projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		prj, err := getProject(r, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		receivers, err := svc.ListReceivers(r.Context(), prj.GetSlug())
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusOK, listResponse[alertsv1.Receiver]{Items: receivers})
+	}
+}
+
+func handleListFirehoseSubscriptions(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		target, err := getAlertTarget(r, client, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		subscriptions, err := svc.ListSubscriptions(r.Context(), target.prj.GetSlug(), subscriptionMatch(target.name))
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusOK, listResponse[alertsv1.Subscription]{Items: subscriptions})
+	}
+}
+
+func handleCreateFirehoseSubscription(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		target, err := getAlertTarget(r, client, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		var req createSubscriptionRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			utils.WriteErr(w, errors.ErrInvalid.
+				WithMsgf("request json body is not valid").
+				WithCausef(err.Error()))
+			return
+		}
+
+		sub, err := req.toSubscription(target.name)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		created, err := svc.CreateSubscription(r.Context(), target.prj.GetSlug(), sub)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusCreated, created)
+	}
+}
+
+func handleDeleteFirehoseSubscription(client entropyv1beta1.ResourceServiceClient, projects *projectsv1.Resolver, svc *alertsv1.Service) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		target, err := getAlertTarget(r, client, projects)
+		if err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		// subscriptions of other firehoses are reported as not found.
+		id := mux.Vars(r)[pathParamSubscriptionID]
+		if err := svc.DeleteSubscription(r.Context(), target.prj.GetSlug(), id, subscriptionMatch(target.name)); err != nil {
+			utils.WriteErr(w, err)
+			return
+		}
+
+		utils.WriteJSON(w, http.StatusNoContent, nil)
+	}
+}
+
+func subscriptionMatch(name string) map[string]string {
+	return map[string]string{subscriptionMatchLabel: name}
+}
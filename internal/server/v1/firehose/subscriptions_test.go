@@ -0,0 +1,38 @@
+package firehose
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	alertsv1 "github.com/odpf/dex/internal/server/v1/alert"
+)
+
+func Test_createSubscriptionRequest_toSubscription(t *testing.T) {
+	t.Parallel()
+
+	receivers := []alertsv1.SubscriptionReceiver{{ID: "7"}}
+
+	sub, err := createSubscriptionRequest{
+		Receivers: receivers,
+		Match:     map[string]string{"severity": "CRITICAL"},
+	}.toSubscription("fh-1-firehose")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(sub.URN, "fh-1-firehose-"))
+	assert.Equal(t, map[string]string{"name": "fh-1-firehose", "severity": "CRITICAL"}, sub.Match)
+
+	sub, err = createSubscriptionRequest{URN: "pager", Receivers: receivers}.toSubscription("fh-1-firehose")
+	require.NoError(t, err)
+	assert.Equal(t, "pager", sub.URN)
+
+	_, err = createSubscriptionRequest{}.toSubscription("fh-1-firehose")
+	assert.Error(t, err)
+
+	_, err = createSubscriptionRequest{
+		Receivers: receivers,
+		Match:     map[string]string{"name": "fh-2-firehose"},
+	}.toSubscription("fh-1-firehose")
+	assert.Error(t, err)
+}
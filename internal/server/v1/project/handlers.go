@@ -3,6 +3,7 @@ package project
 import (
 	"net/http"
 
+	"github.com/gorilla/mux"
 	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
 
 	"github.com/odpf/dex/internal/server/utils"
@@ -12,9 +13,9 @@ type listResponse[T any] struct {
 	Items []T `json:"items"`
 }
 
-func handleGetProject(client shieldv1beta1.ShieldServiceClient) http.HandlerFunc {
+func handleGetProject(projects *Resolver) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		prj, err := getProject(r, client)
+		prj, err := projects.Resolve(r, mux.Vars(r)[pathParamSlug])
 		if err != nil {
 			utils.WriteErr(w, err)
 			return
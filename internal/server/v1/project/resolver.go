@@ -0,0 +1,240 @@
+package project
+
+import (
+	"container/list"
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+const (
+	cacheKeySlug = "slug:"
+	cacheKeyID   = "id:"
+
+	// lookupTimeout bounds a Shield lookup, which is not bound to the
+	// context of any of the callers sharing it.
+	lookupTimeout = 10 * time.Second
+)
+
+var (
+	keyCacheResult = tag.MustNewKey("result")
+
+	measureCacheLookups = stats.Int64("dex/project_cache/lookups",
+		"Number of project lookups served by the project cache", stats.UnitDimensionless)
+
+	// CacheViews are the views exposing the hit/miss counts of the project
+	// cache. These need to be registered for the metrics to be exported.
+	CacheViews = []*view.View{
+		{
+			Name:        "dex/project_cache/lookups",
+			Description: "Project cache lookups by result (hit or miss)",
+			Measure:     measureCacheLookups,
+			Aggregation: view.Count(),
+			TagKeys:     []tag.Key{keyCacheResult},
+		},
+	}
+)
+
+// CacheConfig configures the caching of Shield projects.
+type CacheConfig struct {
+	TTL     time.Duration `mapstructure:"ttl" default:"5m"`
+	MaxSize int           `mapstructure:"max_size" default:"1000"`
+}
+
+// Resolver resolves Shield projects by slug or ID. Resolved projects are
+// cached and concurrent lookups of the same project share a single call
+// to Shield.
+type Resolver struct {
+	shield shieldv1beta1.ShieldServiceClient
+	cfg    CacheConfig
+	group  singleflight.Group
+
+	mu      sync.Mutex
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type cacheEntry struct {
+	key       string
+	project   *shieldv1beta1.Project
+	expiresAt time.Time
+}
+
+// NewResolver returns a project resolver using the given Shield client.
+// Caching is disabled if TTL or MaxSize is not positive.
+func NewResolver(shield shieldv1beta1.ShieldServiceClient, cfg CacheConfig) *Resolver {
+	return &Resolver{
+		shield:  shield,
+		cfg:     cfg,
+		lru:     list.New(),
+		entries: map[string]*list.Element{},
+	}
+}
+
+// Resolve returns the project with the given slug. If the request has the
+// project-id header set, project is looked up by the ID and is verified to
+// have the given slug.
+func (res *Resolver) Resolve(r *http.Request, slug string) (*shieldv1beta1.Project, error) {
+	projectID := strings.TrimSpace(r.Header.Get(headerProjectID))
+	if projectID == "" {
+		return res.BySlug(r.Context(), slug)
+	}
+
+	prj, err := res.ByID(r.Context(), projectID)
+	if err != nil {
+		return nil, err
+	} else if prj.GetSlug() != slug {
+		return nil, errors.ErrNotFound.WithCausef("projectSlug in URL does not match project of given ID")
+	}
+	return prj, nil
+}
+
+// BySlug returns the project with the given slug.
+func (res *Resolver) BySlug(ctx context.Context, slug string) (*shieldv1beta1.Project, error) {
+	key := cacheKeySlug + slug
+	if prj := res.lookup(ctx, key); prj != nil {
+		return prj, nil
+	}
+
+	return res.do(ctx, key, func(ctx context.Context) (*shieldv1beta1.Project, error) {
+		// Shield has no lookup by slug. So list everything and cache all
+		// the projects while searching.
+		projects, err := res.shield.ListProjects(ctx, &shieldv1beta1.ListProjectsRequest{})
+		if err != nil {
+			return nil, err
+		}
+
+		var found *shieldv1beta1.Project
+		for _, prj := range projects.GetProjects() {
+			res.store(prj)
+			if prj.GetSlug() == slug {
+				found = prj
+			}
+		}
+
+		if found == nil {
+			return nil, errors.ErrNotFound
+		}
+		return found, nil
+	})
+}
+
+// ByID returns the project with the given ID.
+func (res *Resolver) ByID(ctx context.Context, id string) (*shieldv1beta1.Project, error) {
+	key := cacheKeyID + id
+	if prj := res.lookup(ctx, key); prj != nil {
+		return prj, nil
+	}
+
+	return res.do(ctx, key, func(ctx context.Context) (*shieldv1beta1.Project, error) {
+		resp, err := res.shield.GetProject(ctx, &shieldv1beta1.GetProjectRequest{Id: id})
+		if err != nil {
+			st := status.Convert(err)
+			if st.Code() == codes.NotFound {
+				return nil, errors.ErrNotFound
+			}
+			return nil, err
+		}
+
+		res.store(resp.GetProject())
+		return resp.GetProject(), nil
+	})
+}
+
+// do runs the lookup once for all the concurrent callers of the key. The
+// lookup runs with its own timeout instead of the context of the caller
+// that started it, so that the cancellation of one caller does not fail
+// the others. Each caller still returns as soon as its context is done.
+func (res *Resolver) do(ctx context.Context, key string,
+	lookup func(ctx context.Context) (*shieldv1beta1.Project, error),
+) (*shieldv1beta1.Project, error) {
+	ch := res.group.DoChan(key, func() (interface{}, error) {
+		lookupCtx, cancel := context.WithTimeout(context.Background(), lookupTimeout)
+		defer cancel()
+		return lookup(lookupCtx)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+
+	case result := <-ch:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*shieldv1beta1.Project), nil
+	}
+}
+
+func (res *Resolver) enabled() bool {
+	return res.cfg.TTL > 0 && res.cfg.MaxSize > 0
+}
+
+func (res *Resolver) lookup(ctx context.Context, key string) *shieldv1beta1.Project {
+	if !res.enabled() {
+		return nil
+	}
+
+	res.mu.Lock()
+	var prj *shieldv1beta1.Project
+	if el, found := res.entries[key]; found {
+		entry := el.Value.(*cacheEntry)
+		if time.Now().Before(entry.expiresAt) {
+			res.lru.MoveToFront(el)
+			prj = entry.project
+		} else {
+			res.remove(el)
+		}
+	}
+	res.mu.Unlock()
+
+	result := "miss"
+	if prj != nil {
+		result = "hit"
+	}
+	_ = stats.RecordWithTags(ctx, []tag.Mutator{tag.Upsert(keyCacheResult, result)}, measureCacheLookups.M(1))
+
+	return prj
+}
+
+func (res *Resolver) store(prj *shieldv1beta1.Project) {
+	if !res.enabled() || prj == nil {
+		return
+	}
+
+	res.mu.Lock()
+	defer res.mu.Unlock()
+
+	expiresAt := time.Now().Add(res.cfg.TTL)
+	for _, key := range []string{cacheKeySlug + prj.GetSlug(), cacheKeyID + prj.GetId()} {
+		entry := &cacheEntry{key: key, project: prj, expiresAt: expiresAt}
+		if el, found := res.entries[key]; found {
+			el.Value = entry
+			res.lru.MoveToFront(el)
+		} else {
+			res.entries[key] = res.lru.PushFront(entry)
+		}
+	}
+
+	// both slug & id are cached for every project.
+	for res.lru.Len() > 2*res.cfg.MaxSize {
+		res.remove(res.lru.Back())
+	}
+}
+
+func (res *Resolver) remove(el *list.Element) {
+	res.lru.Remove(el)
+	delete(res.entries, el.Value.(*cacheEntry).key)
+}
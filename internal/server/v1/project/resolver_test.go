@@ -0,0 +1,122 @@
+package project
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	shieldv1beta1 "go.buf.build/odpf/gwv/odpf/proton/odpf/shield/v1beta1"
+	"google.golang.org/grpc"
+
+	"github.com/odpf/dex/pkg/errors"
+)
+
+type fakeShieldClient struct {
+	shieldv1beta1.ShieldServiceClient
+	projects  []*shieldv1beta1.Project
+	listCalls int32
+}
+
+func (f *fakeShieldClient) ListProjects(ctx context.Context, _ *shieldv1beta1.ListProjectsRequest, _ ...grpc.CallOption) (*shieldv1beta1.ListProjectsResponse, error) {
+	atomic.AddInt32(&f.listCalls, 1)
+	time.Sleep(10 * time.Millisecond)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return &shieldv1beta1.ListProjectsResponse{Projects: f.projects}, nil
+}
+
+func TestResolver(t *testing.T) {
+	t.Parallel()
+
+	newShield := func() *fakeShieldClient {
+		return &fakeShieldClient{
+			projects: []*shieldv1beta1.Project{
+				{Id: "p1", Slug: "foo"},
+				{Id: "p2", Slug: "bar"},
+				{Id: "p3", Slug: "baz"},
+			},
+		}
+	}
+
+	t.Run("CachesAndDeduplicates", func(t *testing.T) {
+		shield := newShield()
+		res := NewResolver(shield, CacheConfig{TTL: time.Minute, MaxSize: 10})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				prj, err := res.BySlug(context.Background(), "foo")
+				assert.NoError(t, err)
+				assert.Equal(t, "p1", prj.GetId())
+			}()
+		}
+		wg.Wait()
+
+		// other projects are cached by the same list call.
+		prj, err := res.ByID(context.Background(), "p2")
+		require.NoError(t, err)
+		assert.Equal(t, "bar", prj.GetSlug())
+		assert.Equal(t, int32(1), atomic.LoadInt32(&shield.listCalls))
+
+		_, err = res.BySlug(context.Background(), "unknown")
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("SlugMismatch", func(t *testing.T) {
+		res := NewResolver(newShield(), CacheConfig{TTL: time.Minute, MaxSize: 10})
+		_, err := res.BySlug(context.Background(), "foo")
+		require.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/projects/bar", nil)
+		req.Header.Set(headerProjectID, "p1")
+		_, err = res.Resolve(req, "bar")
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("BoundedAndExpiring", func(t *testing.T) {
+		shield := newShield()
+		res := NewResolver(shield, CacheConfig{TTL: 20 * time.Millisecond, MaxSize: 2})
+
+		_, err := res.BySlug(context.Background(), "foo")
+		require.NoError(t, err)
+		assert.Equal(t, 4, res.lru.Len())
+
+		time.Sleep(30 * time.Millisecond)
+		_, err = res.BySlug(context.Background(), "baz")
+		require.NoError(t, err)
+		assert.Equal(t, int32(2), atomic.LoadInt32(&shield.listCalls))
+	})
+
+	t.Run("CancelledCallerDoesNotFailOthers", func(t *testing.T) {
+		shield := newShield()
+		res := NewResolver(shield, CacheConfig{TTL: time.Minute, MaxSize: 10})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		firstErr := make(chan error, 1)
+		go func() {
+			_, err := res.BySlug(ctx, "foo")
+			firstErr <- err
+		}()
+
+		// join the lookup started by the first caller, which then goes away.
+		time.Sleep(2 * time.Millisecond)
+		go func() {
+			time.Sleep(time.Millisecond)
+			cancel()
+		}()
+
+		prj, err := res.BySlug(context.Background(), "foo")
+		require.NoError(t, err)
+		assert.Equal(t, "p1", prj.GetId())
+		assert.ErrorIs(t, <-firstErr, context.Canceled)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&shield.listCalls))
+	})
+}
@@ -29,12 +29,36 @@ var (
 		Status:  http.StatusNotFound,
 	}
 
+	ErrUnauthenticated = Error{
+		Code:    "unauthenticated",
+		Message: "Request is not authenticated",
+		Status:  http.StatusUnauthorized,
+	}
+
+	ErrForbidden = Error{
+		Code:    "forbidden",
+		Message: "User is not allowed to perform this action",
+		Status:  http.StatusForbidden,
+	}
+
 	ErrConflict = Error{
 		Code:    "conflict",
 		Message: "An entity with conflicting identifier exists",
 		Status:  http.StatusConflict,
 	}
 
+	ErrPreconditionFailed = Error{
+		Code:    "precondition_failed",
+		Message: "Entity was modified since it was last read",
+		Status:  http.StatusPreconditionFailed,
+	}
+
+	ErrUnsupportedMediaType = Error{
+		Code:    "unsupported_media_type",
+		Message: "Request content-type is not supported",
+		Status:  http.StatusUnsupportedMediaType,
+	}
+
 	ErrInternal = Error{
 		Code:    "internal_error",
 		Message: "Some unexpected error occurred",
@@ -45,11 +69,20 @@ var (
 // Error represents any error returned by the Entropy components along with any
 // relevant context.
 type Error struct {
-	Op      string `json:"op"`
-	Code    string `json:"code"`
-	Cause   string `json:"cause,omitempty"`
-	Message string `json:"message"`
-	Status  int    `json:"status"`
+	Op      string      `json:"op"`
+	Code    string      `json:"code"`
+	Cause   string      `json:"cause,omitempty"`
+	Message string      `json:"message"`
+	Status  int         `json:"status"`
+	Details interface{} `json:"details,omitempty"`
+}
+
+// FieldError describes why the value of a specific field in a request
+// is not valid. A list of these can be attached to ErrInvalid using
+// WithDetails().
+type FieldError struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
 }
 
 // WithOp can be used to add the name of the op where the error occurred.
@@ -79,6 +112,15 @@ func (err Error) WithMsgf(format string, args ...interface{}) Error {
 	return cloned
 }
 
+// WithDetails returns a clone of the error with the details set. Use this
+// when the user needs structured information (e.g., list of FieldError)
+// in addition to the message to act on the error.
+func (err Error) WithDetails(details interface{}) Error {
+	cloned := err.clone()
+	cloned.Details = details
+	return cloned
+}
+
 // Is checks if 'other' is of type Error and has the same code.
 // See https://blog.golang.org/go1.13-errors.
 func (err Error) Is(other error) bool {
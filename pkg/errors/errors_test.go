@@ -257,3 +257,23 @@ func TestError_WithOp(t *testing.T) {
 		})
 	}
 }
+
+func TestError_WithDetails(t *testing.T) {
+	t.Parallel()
+
+	details := []errors.FieldError{
+		{Field: "configs.env_vars.SINK_TYPE", Reason: "required variable is not set"},
+	}
+
+	err := errors.ErrInvalid.WithMsgf("foo").WithDetails(details)
+	want := errors.Error{
+		Code:    "bad_request",
+		Message: "foo",
+		Status:  http.StatusBadRequest,
+		Details: details,
+	}
+
+	assert.Equal(t, want, err)
+	assert.True(t, goerrors.Is(err, errors.ErrInvalid))
+	assert.Nil(t, errors.ErrInvalid.Details)
+}